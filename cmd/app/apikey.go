@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/config"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+const apiKeyUsage = `usage: app apikey [-tenant name] <command>
+
+options:
+  -tenant name          tenant the keys belong to (default "default")
+
+commands:
+  create <name> [role]  create a key and print it once; role is viewer,
+                        editor (default), publisher or admin
+  list                  list keys
+  revoke <id>           revoke a key`
+
+// runAPIKey runs an API key subcommand against the configured storage.
+func runAPIKey(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("apikey", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	tenant := flags.String("tenant", domain.DefaultTenant, "")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%v\n%s", err, apiKeyUsage)
+	}
+	if !domain.ValidTenant(*tenant) {
+		return fmt.Errorf("invalid tenant %q", *tenant)
+	}
+	args = flags.Args()
+	if len(args) == 0 {
+		return fmt.Errorf("no command given\n%s", apiKeyUsage)
+	}
+	if cfg.Storage.Driver == "memory" {
+		return fmt.Errorf("storage driver %q does not keep api keys between runs", cfg.Storage.Driver)
+	}
+
+	store, err := openStorage(cfg)
+	if err != nil {
+		return err
+	}
+	defer store.close()
+	auth := service.NewAuthService(store.apiKeys)
+
+	ctx := domain.WithTenant(context.Background(), *tenant)
+	switch args[0] {
+	case "create":
+		if len(args) < 2 {
+			return fmt.Errorf("create needs a name\n%s", apiKeyUsage)
+		}
+		role := domain.RoleEditor
+		if len(args) > 2 {
+			role = domain.Role(args[2])
+		}
+		created, key, err := auth.CreateAPIKey(ctx, args[1], role)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("id:  %s\nkey: %s\n", created.ID, key)
+		fmt.Fprintln(os.Stderr, "store the key now, it cannot be shown again")
+		return nil
+	case "list":
+		keys, err := auth.ListAPIKeys(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tROLE\tCREATED AT\tREVOKED AT")
+		for _, k := range keys {
+			revoked := "-"
+			if k.RevokedAt != nil {
+				revoked = k.RevokedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", k.ID, k.Name, k.Role, k.CreatedAt.Format(time.RFC3339), revoked)
+		}
+		return w.Flush()
+	case "revoke":
+		if len(args) < 2 {
+			return fmt.Errorf("revoke needs an id\n%s", apiKeyUsage)
+		}
+		id, err := uuid.Parse(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid id %q", args[1])
+		}
+		return auth.RevokeAPIKey(ctx, id)
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], apiKeyUsage)
+	}
+}
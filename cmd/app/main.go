@@ -2,6 +2,10 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"expvar"
+	"fmt"
+	"io/fs"
 	"log"
 	"net/http"
 	"os"
@@ -12,9 +16,16 @@ import (
 	_ "github.com/nightmaker00/accordion-go/docs"
 	"github.com/nightmaker00/accordion-go/internal/api"
 	"github.com/nightmaker00/accordion-go/internal/config"
+	"github.com/nightmaker00/accordion-go/internal/domain"
 	"github.com/nightmaker00/accordion-go/internal/repository"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	sqliterepo "github.com/nightmaker00/accordion-go/internal/repository/sqlite"
 	"github.com/nightmaker00/accordion-go/internal/service"
+	"github.com/nightmaker00/accordion-go/migrations"
+	"github.com/nightmaker00/accordion-go/pkg/db/migrate"
 	"github.com/nightmaker00/accordion-go/pkg/db/postgres"
+	"github.com/nightmaker00/accordion-go/pkg/db/sqlite"
+	"github.com/nightmaker00/accordion-go/pkg/jwks"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
@@ -23,27 +34,81 @@ import (
 // @description Backend for FAQ accordion.
 // @host        localhost:8080
 // @BasePath    /api/v1
+//
+// @securityDefinitions.apikey  ApiKeyAuth
+// @in                          header
+// @name                        X-API-Key
+//
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 JWT as "Bearer <token>"
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("load config: %v", err)
 	}
+	for host, tenant := range cfg.HTTP.TenantHosts {
+		if !domain.ValidTenant(tenant) {
+			log.Fatalf("load config: tenant %q of host %q is invalid", tenant, host)
+		}
+	}
 
-	db, err := postgres.Open(cfg.Config)
-	if err != nil {
-		log.Fatalf("open db: %v", err)
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
 	}
-	defer db.Close()
 
-	if err := db.Ping(); err != nil {
-		log.Fatalf("ping db: %v", err)
+	if len(os.Args) > 1 && os.Args[1] == "apikey" {
+		if err := runAPIKey(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("apikey: %v", err)
+		}
+		return
+	}
+
+	store, err := openStorage(cfg)
+	if err != nil {
+		log.Fatalf("open storage: %v", err)
 	}
+	defer store.close()
 
-	faqRepo := repository.NewFAQRepository(db)
-	faqService := service.NewFAQService(faqRepo)
-	handler := api.NewHandler(faqService)
+	faqRepo := store.faqs
+
+	if cfg.Cache.TTLSeconds > 0 && cfg.Cache.MaxEntries > 0 {
+		cached := service.NewCachedFAQRepository(faqRepo,
+			time.Duration(cfg.Cache.TTLSeconds)*time.Second, cfg.Cache.MaxEntries)
+		expvar.Publish("faq_cache", expvar.Func(func() any { return cached.Stats() }))
+		faqRepo = cached
+	}
+	faqService := service.NewFAQService(faqRepo,
+		service.WithSearchLanguage(cfg.Search.Language),
+		service.WithDefaultLocale(cfg.Locale.Default),
+		service.WithLocaleFallbacks(cfg.Locale.Fallbacks...),
+		service.WithTrashRetention(time.Duration(cfg.Trash.RetentionDays)*24*time.Hour),
+	)
+	categoryService := service.NewCategoryService(store.categories)
+	handler := api.NewHandler(faqService, categoryService,
+		api.WithListCacheControl(cfg.HTTP.ListCacheControl),
+		api.WithAuditService(service.NewAuditService(store.audit)),
+		api.WithTenantHosts(cfg.HTTP.TenantHosts),
+	)
 
-	httpHandler := api.Chain(handler, api.Recover(), api.RequestLogger(), api.CORS())
+	middlewares := []api.Middleware{
+		api.Recover(), api.RequestID(), api.ClientIP(cfg.HTTP.TrustProxy), api.RequestLogger(), api.CORS(),
+	}
+	if cfg.Auth.Enabled {
+		authService, err := newAuthService(cfg, store.apiKeys)
+		if err != nil {
+			log.Fatalf("auth: %v", err)
+		}
+		middlewares = append(middlewares, api.Authenticate(authService))
+	} else {
+		log.Printf("authentication is disabled, every request acts as admin")
+		middlewares = append(middlewares, api.Anonymous())
+	}
+	httpHandler := api.Chain(handler, middlewares...)
 
 	mux := http.NewServeMux()
 	mux.Handle("/", httpHandler)
@@ -65,9 +130,34 @@ func main() {
 		}
 	}()
 
+	// runtime and cache counters go to an internal address only
+	var debugSrv *http.Server
+	if cfg.Server.DebugAddress != "" {
+		debugMux := http.NewServeMux()
+		debugMux.Handle("/debug/vars", expvar.Handler())
+		debugSrv = &http.Server{
+			Addr:        cfg.Server.DebugAddress,
+			Handler:     debugMux,
+			ReadTimeout: time.Duration(cfg.Server.Timeouts.ReadSeconds) * time.Second,
+		}
+		go func() {
+			log.Printf("debug listening on %s", debugSrv.Addr)
+			if err := debugSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("debug listen: %v", err)
+			}
+		}()
+	}
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	if cfg.Trash.RetentionDays > 0 && cfg.Trash.PurgeIntervalMinutes > 0 {
+		go purgeTrash(purgeCtx, faqService, time.Duration(cfg.Trash.PurgeIntervalMinutes)*time.Minute)
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
+	stopPurge()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -75,4 +165,143 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Printf("shutdown: %v", err)
 	}
+	if debugSrv != nil {
+		_ = debugSrv.Shutdown(ctx)
+	}
+}
+
+// storage holds the repositories of the configured storage driver.
+type storage struct {
+	faqs       service.FAQRepository
+	categories service.CategoryRepository
+	apiKeys    service.APIKeyRepository
+	audit      service.AuditRepository
+	close      func()
+}
+
+// openStorage opens the configured storage driver.
+func openStorage(cfg *config.Config) (storage, error) {
+	if cfg.Storage.Driver == "memory" {
+		s := memory.NewStore()
+		return storage{
+			faqs:       memory.NewFAQRepository(s),
+			categories: memory.NewCategoryRepository(s),
+			apiKeys:    memory.NewAPIKeyRepository(s),
+			audit:      memory.NewAuditRepository(s),
+			close:      func() {},
+		}, nil
+	}
+
+	db, migrator, err := openDB(cfg)
+	if err != nil {
+		return storage{}, err
+	}
+	if cfg.Storage.AutoMigrate {
+		if err := migrator.Up(context.Background()); err != nil {
+			_ = db.Close()
+			return storage{}, fmt.Errorf("migrate db: %w", err)
+		}
+	}
+	closeDB := func() { _ = db.Close() }
+
+	if cfg.Storage.Driver == "sqlite" {
+		return storage{
+			faqs:       sqliterepo.NewFAQRepository(db),
+			categories: sqliterepo.NewCategoryRepository(db),
+			apiKeys:    sqliterepo.NewAPIKeyRepository(db),
+			audit:      sqliterepo.NewAuditRepository(db),
+			close:      closeDB,
+		}, nil
+	}
+	return storage{
+		faqs:       repository.NewFAQRepository(db),
+		categories: repository.NewCategoryRepository(db),
+		apiKeys:    repository.NewAPIKeyRepository(db),
+		audit:      repository.NewAuditRepository(db),
+		close:      closeDB,
+	}, nil
+}
+
+// newAuthService builds the authentication of API requests. Without a key
+// set only API keys are accepted.
+func newAuthService(cfg *config.Config, apiKeys service.APIKeyRepository) (*service.AuthService, error) {
+	opts := []service.AuthOption{
+		service.WithJWTIssuer(cfg.Auth.JWTIssuer),
+		service.WithJWTAudience(cfg.Auth.JWTAudience),
+		service.WithJWTRoleClaim(cfg.Auth.JWTRoleClaim),
+		service.WithJWTTenantClaim(cfg.Auth.JWTTenantClaim),
+	}
+	if cfg.Auth.JWKSFile != "" {
+		keys, err := jwks.Load(cfg.Auth.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, service.WithJWTKeys(keys))
+	}
+	return service.NewAuthService(apiKeys, opts...), nil
+}
+
+// openDB connects to the database of the configured SQL storage driver and
+// returns a migrator loaded with the migrations of that driver.
+func openDB(cfg *config.Config) (*sql.DB, *migrate.Migrator, error) {
+	var (
+		db      *sql.DB
+		dialect migrate.Dialect
+		scripts fs.FS = migrations.Postgres
+		err     error
+	)
+	switch cfg.Storage.Driver {
+	case "postgres":
+		db, err = postgres.Open(cfg.Config)
+		dialect = migrate.Postgres
+	case "sqlite":
+		db, err = sqlite.Open(cfg.SQLite)
+		dialect = migrate.SQLite
+		if err == nil {
+			scripts, err = fs.Sub(migrations.SQLite, "sqlite")
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+	if err != nil {
+		if db != nil {
+			_ = db.Close()
+		}
+		return nil, nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("ping db: %w", err)
+	}
+	migrator, err := migrate.New(db, dialect, scripts)
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+	return db, migrator, nil
+}
+
+// purgeTrash periodically removes FAQs kept in the trash longer than the
+// configured retention period, in every tenant.
+func purgeTrash(ctx context.Context, faqService *service.FAQService, interval time.Duration) {
+	ctx = domain.WithTenant(ctx, domain.AnyTenant)
+	ctx = domain.WithPrincipal(ctx, domain.Principal{Subject: "trash-purge", Role: domain.RoleAdmin})
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := faqService.PurgeExpired(ctx)
+		if err != nil {
+			log.Printf("purge trash: %v", err)
+		} else if purged > 0 {
+			log.Printf("purged %d faqs from trash", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
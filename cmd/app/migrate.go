@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/config"
+)
+
+const migrateUsage = `usage: app migrate <command>
+
+commands:
+  up             apply all pending migrations
+  down [n]       roll back the last n migrations (default 1)
+  status         list migrations and when they were applied
+  goto <version> migrate up or down to the given version, 0 rolls back everything`
+
+// runMigrate runs a migration subcommand against the configured storage.
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no command given\n%s", migrateUsage)
+	}
+	if cfg.Storage.Driver == "memory" {
+		return fmt.Errorf("storage driver %q has no migrations", cfg.Storage.Driver)
+	}
+
+	db, migrator, err := openDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		return migrator.Down(ctx, steps)
+	case "goto":
+		if len(args) < 2 {
+			return fmt.Errorf("goto needs a version\n%s", migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		return migrator.Goto(ctx, version)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			if s.Missing {
+				applied += " (scripts missing)"
+			}
+			fmt.Fprintf(w, "%06d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], migrateUsage)
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type AuditService interface {
+	List(ctx context.Context, filter domain.AuditFilter, cursor string) (domain.AuditPage, error)
+}
+
+// WithAuditService serves the audit log. Without it /audit is not found.
+func WithAuditService(s AuditService) HandlerOption {
+	return func(h *Handler) {
+		h.auditService = s
+	}
+}
+
+func (h *Handler) serveAudit(w http.ResponseWriter, r *http.Request, rest string) {
+	if h.auditService == nil || (rest != "" && rest != "/") {
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+		return
+	}
+	h.handleListAudit(w, r)
+}
+
+// ListAudit returns a page of the audit log.
+//
+// @Summary      List audit events
+// @Description  Get every change made to FAQs and their translations, newest first, with before/after snapshots. Actions are create, update, delete, restore and purge; events of a translation carry its locale. The time range includes from and excludes to. Pass next_cursor from the response as cursor to get the next page, keeping the same filters.
+// @Tags         audit
+// @Produce      json
+// @Param        actor   query     string  false  "Actor who made the change"
+// @Param        faq_id  query     string  false  "FAQ ID"
+// @Param        from    query     string  false  "Changed at or after (RFC 3339)"
+// @Param        to      query     string  false  "Changed before (RFC 3339)"
+// @Param        limit   query     int     false  "Page size (1-100, default 20)"
+// @Param        cursor  query     string  false  "Cursor from the previous page"
+// @Success      200  {object}  domain.AuditPageResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /audit [get]
+func (h *Handler) handleListAudit(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := domain.AuditFilter{Actor: query.Get("actor")}
+	if raw := query.Get("faq_id"); raw != "" {
+		faqID, err := uuid.Parse(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid faq_id"})
+			return
+		}
+		filter.FAQID = &faqID
+	}
+	var ok bool
+	if filter.From, ok = parseTimeParam(w, query, "from"); !ok {
+		return
+	}
+	if filter.To, ok = parseTimeParam(w, query, "to"); !ok {
+		return
+	}
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid limit"})
+			return
+		}
+		filter.Limit = limit
+	}
+
+	page, err := h.auditService.List(r.Context(), filter, query.Get("cursor"))
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := make([]domain.AuditEventFullResponse, 0, len(page.Items))
+	for _, e := range page.Items {
+		out = append(out, toAuditEventResponse(e))
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.AuditEventFullResponse]{Data: out, NextCursor: page.NextCursor})
+}
+
+func toAuditEventResponse(e domain.AuditEvent) domain.AuditEventFullResponse {
+	return domain.AuditEventFullResponse{
+		ID:        e.ID,
+		Action:    string(e.Action),
+		Actor:     e.Actor,
+		FAQID:     e.FAQID,
+		Locale:    e.Locale,
+		Before:    e.Before,
+		After:     e.After,
+		RequestID: e.RequestID,
+		ClientIP:  e.ClientIP,
+		CreatedAt: e.CreatedAt,
+	}
+}
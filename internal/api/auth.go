@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type Authenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (domain.Principal, error)
+	AuthenticateToken(ctx context.Context, token string) (domain.Principal, error)
+}
+
+// Authenticate reads an API key from X-API-Key or a JWT from
+// "Authorization: Bearer" and stores the caller in the request context.
+// Reads pass without credentials and the service decides which of them
+// are public; every other method needs valid credentials. Invalid
+// credentials are rejected on any method rather than ignored.
+func Authenticate(auth Authenticator) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok, err := authenticate(r, auth)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="faq"`)
+				writeServiceError(w, err)
+				return
+			}
+			if !ok && !isSafeMethod(r.Method) {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="faq"`)
+				writeServiceError(w, domain.ErrUnauthorized)
+				return
+			}
+			if ok {
+				r = r.WithContext(domain.WithPrincipal(r.Context(), principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Anonymous lets every request in as an admin of any tenant named by the
+// X-Actor header. It replaces Authenticate when authentication is disabled.
+func Anonymous() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := domain.Principal{
+				Subject: strings.TrimSpace(r.Header.Get("X-Actor")),
+				Method:  domain.AuthMethodNone,
+				Role:    domain.RoleAdmin,
+			}
+			next.ServeHTTP(w, r.WithContext(domain.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// authenticate reports ok=false when the request carries no credentials.
+func authenticate(r *http.Request, auth Authenticator) (domain.Principal, bool, error) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			return domain.Principal{}, false, domain.ErrUnauthorized
+		}
+		p, err := auth.AuthenticateToken(r.Context(), strings.TrimSpace(token))
+		return p, err == nil, err
+	}
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		p, err := auth.AuthenticateAPIKey(r.Context(), strings.TrimSpace(key))
+		return p, err == nil, err
+	}
+	return domain.Principal{}, false, nil
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -0,0 +1,118 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nightmaker00/accordion-go/internal/api"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+	"github.com/nightmaker00/accordion-go/pkg/jwks"
+)
+
+func TestAuthenticate(t *testing.T) {
+	store := memory.NewStore()
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	keys, err := jwks.Parse([]byte(`{"keys": [{"kty": "oct", "kid": "hs", "alg": "HS256", "k": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY"}]}`))
+	if err != nil {
+		t.Fatalf("parse keys: %v", err)
+	}
+	auth := service.NewAuthService(memory.NewAPIKeyRepository(store), service.WithJWTKeys(keys))
+	h := api.Chain(api.NewHandler(
+		service.NewFAQService(memory.NewFAQRepository(store)),
+		service.NewCategoryService(memory.NewCategoryRepository(store)),
+	), api.Authenticate(auth))
+
+	ctx := context.Background()
+	_, editorKey, err := auth.CreateAPIKey(ctx, "ci", domain.RoleEditor)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	_, viewerKey, err := auth.CreateAPIKey(ctx, "reader", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	revoked, revokedKey, err := auth.CreateAPIKey(ctx, "old", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	if err := auth.RevokeAPIKey(ctx, revoked.ID); err != nil {
+		t.Fatalf("revoke key: %v", err)
+	}
+	token := func(exp time.Time) string {
+		tok := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "alice", "role": "editor", "exp": exp.Unix()})
+		tok.Header["kid"] = "hs"
+		s, err := tok.SignedString(secret)
+		if err != nil {
+			t.Fatalf("sign token: %v", err)
+		}
+		return s
+	}
+
+	position := 0
+	faq := func() string {
+		position++
+		return fmt.Sprintf(`{"title": "Shipping", "content": "Two days", "position": %d, "is_active": true, "status": "draft"}`, position)
+	}
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		header []string
+		status int
+	}{
+		{"public get", http.MethodGet, "", nil, http.StatusOK},
+		{"post without credentials", http.MethodPost, faq(), nil, http.StatusUnauthorized},
+		{"post with api key", http.MethodPost, faq(), []string{"X-API-Key", editorKey}, http.StatusCreated},
+		{"post with token", http.MethodPost, faq(), []string{"Authorization", "Bearer " + token(time.Now().Add(time.Hour))}, http.StatusCreated},
+		{"post with lowercase scheme", http.MethodPost, faq(), []string{"Authorization", "bearer " + token(time.Now().Add(time.Hour))}, http.StatusCreated},
+		{"post as viewer", http.MethodPost, faq(), []string{"X-API-Key", viewerKey}, http.StatusForbidden},
+		{"post with revoked key", http.MethodPost, faq(), []string{"X-API-Key", revokedKey}, http.StatusUnauthorized},
+		{"get with revoked key", http.MethodGet, "", []string{"X-API-Key", revokedKey}, http.StatusUnauthorized},
+		{"get with unknown key", http.MethodGet, "", []string{"X-API-Key", "faq_unknown"}, http.StatusUnauthorized},
+		{"post with expired token", http.MethodPost, faq(), []string{"Authorization", "Bearer " + token(time.Now().Add(-time.Hour))}, http.StatusUnauthorized},
+		{"get with expired token", http.MethodGet, "", []string{"Authorization", "Bearer " + token(time.Now().Add(-time.Hour))}, http.StatusUnauthorized},
+		{"basic auth", http.MethodGet, "", []string{"Authorization", "Basic YWxpY2U6c2VjcmV0"}, http.StatusUnauthorized},
+		{"empty bearer", http.MethodGet, "", []string{"Authorization", "Bearer "}, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, h, tt.method, "/api/v1/faqs", tt.body, tt.header...)
+			if rec.Code != tt.status {
+				t.Fatalf("status %d, want %d: %s", rec.Code, tt.status, rec.Body)
+			}
+			challenge := rec.Header().Get("WWW-Authenticate")
+			if tt.status == http.StatusUnauthorized && challenge == "" {
+				t.Errorf("401 without WWW-Authenticate")
+			}
+			if tt.status != http.StatusUnauthorized && challenge != "" {
+				t.Errorf("WWW-Authenticate on status %d", rec.Code)
+			}
+		})
+	}
+
+	// mutations other than create are guarded the same way
+	id := createFAQ(t, api.Chain(h, withHeader("X-API-Key", editorKey)), faq())
+	for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		if rec := do(t, h, method, "/api/v1/faqs/"+id, `{"is_active": false}`); rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s without credentials: status %d, want 401", method, rec.Code)
+		}
+	}
+	if rec := do(t, h, http.MethodGet, "/api/v1/faqs/"+id, ""); rec.Code != http.StatusOK {
+		t.Errorf("get one without credentials: status %d, want 200", rec.Code)
+	}
+}
+
+func withHeader(name, value string) api.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Header.Set(name, value)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// BatchFAQs applies several FAQ changes in one request.
+//
+// @Summary      Batch FAQ changes
+// @Description  Apply up to 100 creates, updates and deletes in order. With atomic all operations are stored in one transaction: when one fails nothing is stored, it reports its own error and every other operation reports 424. Otherwise each operation is applied on its own, like the single-FAQ endpoints. Every operation reports the status it would have had on its own, with the FAQ for creates and updates or the error. The response is 200 when all operations succeeded and 207 otherwise. An update with a version only applies to that version.
+// @Tags         faqs
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.BatchRequest  true  "Operations"
+// @Success      200      {object}  domain.BatchResponse
+// @Success      207      {object}  domain.BatchResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/batch [post]
+func (h *Handler) handleBatchFAQs(w http.ResponseWriter, r *http.Request) {
+	var req domain.BatchRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ops := make([]domain.BatchOperation, 0, len(req.Operations))
+	for i, it := range req.Operations {
+		op, err := toBatchOperation(it)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: fmt.Sprintf("operations[%d]: %v", i, err)})
+			return
+		}
+		ops = append(ops, op)
+	}
+
+	results, err := h.faqService.Batch(r.Context(), ops, req.Atomic)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	out := make([]domain.BatchOperationResponse, 0, len(results))
+	for i, res := range results {
+		if res.Err != nil {
+			status = http.StatusMultiStatus
+			code, resp := batchErrorResponse(res.Err)
+			out = append(out, domain.BatchOperationResponse{Status: code, Error: &resp})
+			continue
+		}
+		item := domain.BatchOperationResponse{Status: http.StatusOK}
+		if ops[i].Action == domain.BatchCreate {
+			item.Status = http.StatusCreated
+		}
+		if res.FAQ != nil {
+			data := toFAQFullResponse(*res.FAQ)
+			item.Data = &data
+		}
+		out = append(out, item)
+	}
+	writeJSON(w, status, domain.BatchResponse{Data: out})
+}
+
+// toBatchOperation checks the fields of an operation against its kind.
+// is_active defaults to true for creates and updates alike.
+func toBatchOperation(req domain.BatchOperationRequest) (domain.BatchOperation, error) {
+	op := domain.BatchOperation{Action: domain.BatchAction(req.Op)}
+	if !op.Action.Valid() {
+		return domain.BatchOperation{}, errors.New("op must be create, update or delete")
+	}
+	if req.ID != nil {
+		op.ID = *req.ID
+	}
+	switch {
+	case op.Action == domain.BatchCreate && req.ID != nil:
+		return domain.BatchOperation{}, errors.New("id is not allowed for create")
+	case op.Action != domain.BatchCreate && (req.ID == nil || *req.ID == uuid.Nil):
+		return domain.BatchOperation{}, errors.New("id is required")
+	case op.Action != domain.BatchCreate && req.Status != nil:
+		return domain.BatchOperation{}, errors.New("status is only allowed for create")
+	case op.Action != domain.BatchUpdate && req.Version != 0:
+		return domain.BatchOperation{}, errors.New("version is only allowed for update")
+	case req.Version < 0:
+		return domain.BatchOperation{}, errors.New("version must not be negative")
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	switch op.Action {
+	case domain.BatchCreate:
+		op.Create = domain.CreateFAQInput{
+			CategoryID:    req.CategoryID,
+			Title:         req.Title,
+			Content:       req.Content,
+			ContentFormat: domain.ContentFormat(req.ContentFormat),
+			Position:      req.Position,
+			IsActive:      isActive,
+			PublishAt:     req.PublishAt,
+			ExpireAt:      req.ExpireAt,
+		}
+		if req.Status != nil {
+			op.Create.Status = domain.FAQStatus(*req.Status)
+		}
+	case domain.BatchUpdate:
+		op.Update = domain.UpdateFAQInput{
+			CategoryID:    req.CategoryID,
+			Title:         req.Title,
+			Content:       req.Content,
+			ContentFormat: domain.ContentFormat(req.ContentFormat),
+			Position:      req.Position,
+			IsActive:      isActive,
+			PublishAt:     req.PublishAt,
+			ExpireAt:      req.ExpireAt,
+			Version:       req.Version,
+		}
+	}
+	return op, nil
+}
+
+func batchErrorResponse(err error) (int, domain.ErrorResponse) {
+	if errors.Is(err, domain.ErrBatchRolledBack) {
+		return http.StatusFailedDependency, domain.ErrorResponse{Error: err.Error()}
+	}
+	return serviceErrorResponse(err)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// writeCacheable writes a JSON response that browsers and CDNs may cache.
+// The ETag is derived from the body, so any change of the content changes
+// it. Requests whose validators still match get 304 Not Modified.
+func (h *Handler) writeCacheable(w http.ResponseWriter, r *http.Request, v any, lastModified time.Time) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, domain.ErrorResponse{Error: "internal error"})
+		return
+	}
+	body = append(body, '\n')
+	h.writeCacheableBody(w, r, "application/json; charset=utf-8", body, lastModified)
+}
+
+// writeCacheableBody is writeCacheable for a body that is already encoded.
+func (h *Handler) writeCacheableBody(w http.ResponseWriter, r *http.Request, contentType string, body []byte, lastModified time.Time) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	if !lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	if h.listCacheControl != "" {
+		w.Header().Set("Cache-Control", h.listCacheControl)
+	}
+
+	if notModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// notModified evaluates If-None-Match and, only when it is absent,
+// If-Modified-Since, as RFC 9110 prescribes for GET.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if raw := r.Header.Get("If-None-Match"); raw != "" {
+		for _, candidate := range strings.Split(raw, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
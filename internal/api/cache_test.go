@@ -0,0 +1,194 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/api"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+// testClock is a clock that only moves when told to, shared by the store
+// and the services.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// newTestServer wires the handler the way main does, over memory storage
+// and with authentication disabled.
+func newTestServer(t *testing.T) (http.Handler, *testClock) {
+	t.Helper()
+	clock := &testClock{now: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	store := memory.NewStore(memory.WithClock(clock.Now))
+	faqRepo := service.NewCachedFAQRepository(memory.NewFAQRepository(store), time.Minute, 100,
+		service.WithCacheClock(clock.Now))
+	handler := api.NewHandler(
+		service.NewFAQService(faqRepo, service.WithClock(clock.Now)),
+		service.NewCategoryService(memory.NewCategoryRepository(store)),
+		api.WithListCacheControl("public, max-age=60"),
+	)
+	return api.Chain(handler, api.Anonymous()), clock
+}
+
+func do(t *testing.T, h http.Handler, method, target, body string, header ...string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// createFAQ creates an active published FAQ and returns its ID.
+func createFAQ(t *testing.T, h http.Handler, body string) string {
+	t.Helper()
+	rec := do(t, h, http.MethodPost, "/api/v1/faqs", body)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create faq: status %d: %s", rec.Code, rec.Body)
+	}
+	var out domain.FAQResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+		t.Fatalf("decode created faq: %v", err)
+	}
+	return out.Data.ID.String()
+}
+
+func TestListConditionalGet(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, h http.Handler, id string)
+		// statuses for If-None-Match and If-Modified-Since
+		want [2]int
+	}{
+		{
+			name:   "unchanged",
+			change: func(t *testing.T, h http.Handler, id string) {},
+			want:   [2]int{http.StatusNotModified, http.StatusNotModified},
+		},
+		{
+			name: "deactivated",
+			change: func(t *testing.T, h http.Handler, id string) {
+				if rec := do(t, h, http.MethodPatch, "/api/v1/faqs/"+id, `{"is_active": false}`); rec.Code != http.StatusOK {
+					t.Fatalf("deactivate: status %d: %s", rec.Code, rec.Body)
+				}
+			},
+			want: [2]int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name: "deleted",
+			change: func(t *testing.T, h http.Handler, id string) {
+				if rec := do(t, h, http.MethodDelete, "/api/v1/faqs/"+id, ""); rec.Code != http.StatusOK {
+					t.Fatalf("delete: status %d: %s", rec.Code, rec.Body)
+				}
+			},
+			want: [2]int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name: "translated",
+			change: func(t *testing.T, h http.Handler, id string) {
+				rec := do(t, h, http.MethodPut, "/api/v1/faqs/"+id+"/translations/de", `{"title": "Versand", "content": "Zwei Tage"}`)
+				if rec.Code != http.StatusOK {
+					t.Fatalf("translate: status %d: %s", rec.Code, rec.Body)
+				}
+			},
+			// the list is in English, so the body and its ETag stay and
+			// only the time moves
+			want: [2]int{http.StatusNotModified, http.StatusOK},
+		},
+	}
+	for _, tt := range tests {
+		for i, validator := range []string{"If-None-Match", "If-Modified-Since"} {
+			want := tt.want[i]
+			t.Run(tt.name+"/"+validator, func(t *testing.T) {
+				h, clock := newTestServer(t)
+				createFAQ(t, h, `{"title": "Returns", "content": "30 days", "position": 1, "is_active": true}`)
+				id := createFAQ(t, h, `{"title": "Shipping", "content": "Two days", "position": 2, "is_active": true}`)
+
+				first := do(t, h, http.MethodGet, "/api/v1/faqs", "")
+				if first.Code != http.StatusOK {
+					t.Fatalf("first get: status %d", first.Code)
+				}
+				header := map[string]string{
+					"If-None-Match":     first.Header().Get("ETag"),
+					"If-Modified-Since": first.Header().Get("Last-Modified"),
+				}
+				if header[validator] == "" {
+					t.Fatalf("first get has no %s validator: %v", validator, first.Header())
+				}
+
+				clock.Advance(time.Minute)
+				tt.change(t, h, id)
+
+				again := do(t, h, http.MethodGet, "/api/v1/faqs", "", validator, header[validator])
+				if again.Code != want {
+					t.Fatalf("get with %s: status %d, want %d", validator, again.Code, want)
+				}
+				if want == http.StatusNotModified {
+					if again.Body.Len() != 0 {
+						t.Errorf("304 has a body: %s", again.Body)
+					}
+					return
+				}
+
+				// the new validators match until the next change
+				next := again.Header().Get("ETag")
+				if validator == "If-Modified-Since" {
+					next = again.Header().Get("Last-Modified")
+					if next == header[validator] {
+						t.Errorf("Last-Modified stayed at %s", next)
+					}
+				}
+				if rec := do(t, h, http.MethodGet, "/api/v1/faqs", "", validator, next); rec.Code != http.StatusNotModified {
+					t.Errorf("get with the new %s: status %d, want 304", validator, rec.Code)
+				}
+			})
+		}
+	}
+}
+
+func TestListConditionalGetSchedule(t *testing.T) {
+	h, clock := newTestServer(t)
+	expireAt := clock.Now().Add(time.Hour).Format(time.RFC3339)
+	createFAQ(t, h, `{"title": "Sale", "content": "C", "position": 1, "is_active": true, "expire_at": "`+expireAt+`"}`)
+
+	first := do(t, h, http.MethodGet, "/api/v1/faqs", "")
+	lastModified := first.Header().Get("Last-Modified")
+
+	// the FAQ expires without any write
+	clock.Advance(2 * time.Hour)
+	rec := do(t, h, http.MethodGet, "/api/v1/faqs", "", "If-Modified-Since", lastModified)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get after expiry: status %d, want 200", rec.Code)
+	}
+	if got, want := rec.Header().Get("Last-Modified"), clock.Now().Add(-time.Hour).Format(http.TimeFormat); got != want {
+		t.Errorf("Last-Modified = %s, want the expire time %s", got, want)
+	}
+	if rec.Body.String() != "{\"data\":[]}\n" {
+		t.Errorf("body = %s, want an empty list", rec.Body)
+	}
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (h *Handler) serveCategories(w http.ResponseWriter, r *http.Request, rest string) {
+	if rest == "" || rest == "/" {
+		switch r.Method {
+		case http.MethodGet:
+			h.handleListCategories(w, r)
+			return
+		case http.MethodPost:
+			h.handleCreateCategory(w, r)
+			return
+		default:
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+	}
+
+	id, ok := parseIDPath(w, rest)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGetCategory(w, r, id)
+		return
+	case http.MethodPut:
+		h.handleUpdateCategory(w, r, id)
+		return
+	case http.MethodDelete:
+		h.handleDeleteCategory(w, r, id)
+		return
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+		return
+	}
+}
+
+// ListCategories returns all categories.
+//
+// @Summary      List categories
+// @Description  Get all categories ordered by position
+// @Tags         categories
+// @Produce      json
+// @Success      200  {object}  domain.CategoryListResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Router       /categories [get]
+func (h *Handler) handleListCategories(w http.ResponseWriter, r *http.Request) {
+	items, err := h.categoryService.List(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := make([]domain.CategoryFullResponse, 0, len(items))
+	for _, it := range items {
+		out = append(out, toCategoryFullResponse(it))
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.CategoryFullResponse]{Data: out})
+}
+
+// GetCategory returns a category by ID.
+//
+// @Summary      Get category
+// @Description  Get one category by id
+// @Tags         categories
+// @Produce      json
+// @Param        id   path      string  true  "Category ID"
+// @Success      200  {object}  domain.CategoryResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Router       /categories/{id} [get]
+func (h *Handler) handleGetCategory(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	category, err := h.categoryService.GetByID(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[domain.CategoryFullResponse]{Data: toCategoryFullResponse(category)})
+}
+
+// CreateCategory creates a new category.
+//
+// @Summary      Create category
+// @Description  Create new category, optionally nested into a parent category
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.CreateCategoryRequest  true  "Category payload"
+// @Success      201      {object}  domain.CategoryResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /categories [post]
+func (h *Handler) handleCreateCategory(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateCategoryRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	created, err := h.categoryService.Create(r.Context(), domain.CreateCategoryInput{
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Slug:     req.Slug,
+		Position: req.Position,
+	})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, domain.DataResponse[domain.CategoryFullResponse]{Data: toCategoryFullResponse(created)})
+}
+
+// UpdateCategory updates a category.
+//
+// @Summary      Update category
+// @Description  Update category by id
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                        true  "Category ID"
+// @Param        payload  body      domain.UpdateCategoryRequest  true  "Category payload"
+// @Success      200      {object}  domain.CategoryResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      404      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /categories/{id} [put]
+func (h *Handler) handleUpdateCategory(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	var req domain.UpdateCategoryRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	updated, err := h.categoryService.Update(r.Context(), id, domain.UpdateCategoryInput{
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Slug:     req.Slug,
+		Position: req.Position,
+	})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[domain.CategoryFullResponse]{Data: toCategoryFullResponse(updated)})
+}
+
+// DeleteCategory deletes a category.
+//
+// @Summary      Delete category
+// @Description  Delete category by id. Its FAQs become uncategorized and its subcategories move to the top level.
+// @Tags         categories
+// @Produce      json
+// @Param        id   path      string  true  "Category ID"
+// @Success      200  {object}  domain.MessageResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /categories/{id} [delete]
+func (h *Handler) handleDeleteCategory(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	if err := h.categoryService.Delete(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.MessageResponse{Message: "Category deleted successfully"})
+}
+
+func toCategoryFullResponse(c domain.Category) domain.CategoryFullResponse {
+	return domain.CategoryFullResponse{
+		ID:       c.ID,
+		ParentID: c.ParentID,
+		Name:     c.Name,
+		Slug:     c.Slug,
+		Position: c.Position,
+	}
+}
+
+func toFAQGroupResponses(groups []domain.FAQGroup) []domain.FAQGroupResponse {
+	out := make([]domain.FAQGroupResponse, 0, len(groups))
+	for _, g := range groups {
+		group := domain.FAQGroupResponse{
+			Items:    make([]domain.FAQListItemResponse, 0, len(g.Items)),
+			Children: toFAQGroupResponses(g.Children),
+		}
+		if g.Category != nil {
+			category := toCategoryFullResponse(*g.Category)
+			group.Category = &category
+		}
+		for _, it := range g.Items {
+			group.Items = append(group.Items, toFAQListItemResponse(it))
+		}
+		out = append(out, group)
+	}
+	return out
+}
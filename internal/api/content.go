@@ -0,0 +1,95 @@
+package api
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// markdown renders GitHub flavored Markdown. Raw HTML in the source is
+// dropped rather than passed through; the sanitizer runs afterwards all
+// the same.
+var markdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// contentPolicy is the allow-list rendered content is cut down to: text
+// structure, emphasis, code, tables and links. Images, styles, forms,
+// scripts and event handlers never make it through.
+var contentPolicy = func() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6",
+		"strong", "b", "em", "i", "s", "del", "code", "pre", "blockquote",
+		"ul", "ol", "li", "table", "thead", "tbody", "tr", "th", "td")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowRelativeURLs(true)
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}()
+
+// answerPolicy is the markup search engines accept in the answers of FAQ
+// structured data. Other tags are stripped, keeping their text.
+var answerPolicy = func() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("p", "div", "br", "h1", "h2", "h3", "h4", "h5", "h6",
+		"ul", "ol", "li", "strong", "b", "em", "i")
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowRelativeURLs(true)
+	p.RequireParseableURLs(true)
+	return p
+}()
+
+// blankLines are the runs of empty lines stripped tags leave behind.
+var blankLines = regexp.MustCompile(`\n\s*\n`)
+
+// renderAnswer renders content for the answer of FAQ structured data.
+func renderAnswer(format domain.ContentFormat, content string) string {
+	text := answerPolicy.Sanitize(renderContent(format, content))
+	return blankLines.ReplaceAllString(strings.TrimSpace(text), "\n")
+}
+
+// renderContent turns content of the given format into HTML that is safe
+// to insert into a page as is.
+func renderContent(format domain.ContentFormat, content string) string {
+	switch format {
+	case domain.ContentMarkdown:
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(content), &buf); err != nil {
+			return renderPlain(content)
+		}
+		return contentPolicy.Sanitize(buf.String())
+	case domain.ContentHTML:
+		return contentPolicy.Sanitize(content)
+	}
+	return renderPlain(content)
+}
+
+// renderPlain escapes plain text, making a paragraph of every block
+// separated by a blank line and keeping single line breaks.
+func renderPlain(content string) string {
+	content = strings.ReplaceAll(strings.TrimSpace(content), "\r\n", "\n")
+	var b strings.Builder
+	for _, block := range strings.Split(content, "\n\n") {
+		block = strings.TrimSpace(block)
+		if block == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(block), "\n", "<br>\n"))
+		b.WriteString("</p>")
+	}
+	return b.String()
+}
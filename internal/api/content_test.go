@@ -0,0 +1,116 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func TestContentPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"script", `<script>alert(1)</script><b>bold</b>`, `<b>bold</b>`},
+		{"event handlers", `<b onclick="steal()" onmouseover="steal()">bold</b>`, `<b>bold</b>`},
+		{"image with onerror", `<img src="x.png" onerror="alert(1)">`, ``},
+		{"javascript href", `<a href="javascript:alert(1)">a</a>`, `a`},
+		{"javascript href in mixed case", `<a href="JaVaScRiPt:alert(1)">a</a>`, `a`},
+		{"data href", `<a href="data:text/html;base64,PHNjcmlwdD4=">a</a>`, `a`},
+		{"iframe", `<iframe src="https://evil.example">x</iframe>`, ``},
+		{"style", `<style>body{display:none}</style><p style="color:red">Hi</p>`, `<p>Hi</p>`},
+		{"form", `<form action="/x"><input name="q"></form>`, ``},
+		{"external link", `<a href="https://example.com">a</a>`, `<a href="https://example.com" rel="nofollow noopener" target="_blank">a</a>`},
+		{"relative link", `<a href="/help">a</a>`, `<a href="/help" rel="nofollow">a</a>`},
+		{"mailto link", `<a href="mailto:help@example.com">a</a>`, `<a href="mailto:help@example.com" rel="nofollow">a</a>`},
+		{"list start", `<ol start="3" type="a"><li>x</li></ol>`, `<ol start="3"><li>x</li></ol>`},
+		{"cell align", `<td align="justify">x</td><th align="center">y</th>`, `<td>x</td><th align="center">y</th>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contentPolicy.Sanitize(tt.in); got != tt.want {
+				t.Errorf("Sanitize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  domain.ContentFormat
+		in      string
+		want    string
+		exclude []string
+	}{
+		{
+			name:   "html script",
+			format: domain.ContentHTML,
+			in:     `<p>Hi<script>alert(1)</script></p>`,
+			want:   `<p>Hi</p>`,
+		},
+		{
+			name:   "html event handlers",
+			format: domain.ContentHTML,
+			in:     `<img src=x onerror="alert(1)"><p onclick="alert(1)">Hi</p>`,
+			want:   `<p>Hi</p>`,
+		},
+		{
+			name:   "html links",
+			format: domain.ContentHTML,
+			in:     `<a href="javascript:alert(1)">a</a> <a href="data:text/html;base64,PHNjcmlwdD4=">b</a>`,
+			want:   `a b`,
+		},
+		{
+			name:   "html iframe and style",
+			format: domain.ContentHTML,
+			in:     `<iframe src="https://evil.example"></iframe><style>p{color:red}</style><p style="color:red">Hi</p>`,
+			want:   `<p>Hi</p>`,
+		},
+		{
+			name:    "raw html in markdown",
+			format:  domain.ContentMarkdown,
+			in:      "Hi <script>alert(1)</script>\n\n<div onclick=\"steal()\">raw</div>\n\n<iframe src=\"https://evil.example\"></iframe>\n\n**bold**",
+			exclude: []string{"<script", "onclick", "<div", "<iframe", "<!--"},
+		},
+		{
+			name:    "markdown links",
+			format:  domain.ContentMarkdown,
+			in:      "[a](javascript:alert(1)) [b](data:text/html;base64,PHNjcmlwdD4=) <javascript:alert(1)>",
+			exclude: []string{"<a", "href"},
+		},
+		{
+			name:   "markdown",
+			format: domain.ContentMarkdown,
+			in:     "| a | b |\n|:-|-:|\n| 1 | 2 |\n\n3. **three** and [help](/help)",
+			want: "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>2</td>\n</tr>\n</tbody>\n</table>\n" +
+				"<ol start=\"3\">\n<li><strong>three</strong> and <a href=\"/help\" rel=\"nofollow\">help</a></li>\n</ol>\n",
+		},
+		{
+			name:   "plain",
+			format: domain.ContentPlain,
+			in:     "a < b & \"c\"\nnext line\n\n<script>alert(1)</script>",
+			want:   "<p>a &lt; b &amp; &#34;c&#34;<br>\nnext line</p>\n<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>",
+		},
+		{
+			name: "no format",
+			in:   "one\r\ntwo\r\n\r\n\r\n\r\nthree\n",
+			want: "<p>one<br>\ntwo</p>\n<p>three</p>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderContent(tt.format, tt.in)
+			if tt.exclude == nil && got != tt.want {
+				t.Errorf("renderContent(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			for _, s := range tt.exclude {
+				if strings.Contains(got, s) {
+					t.Errorf("renderContent(%q) = %q, has %q", tt.in, got, s)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// writeFAQ writes a single FAQ together with the ETag of its version.
+func writeFAQ(w http.ResponseWriter, status int, faq domain.FAQ) {
+	w.Header().Set("ETag", faqETag(faq.Version))
+	writeJSON(w, status, domain.DataResponse[domain.FAQFullResponse]{Data: toFAQFullResponse(faq)})
+}
+
+func faqETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// ifMatchVersion returns the FAQ version required by the If-Match header,
+// or 0 when the header is absent or "*". ok is false when the header can
+// never match a FAQ version, which includes weak tags: If-Match uses
+// strong comparison.
+func ifMatchVersion(r *http.Request) (version int, ok bool) {
+	raw := strings.TrimSpace(r.Header.Get("If-Match"))
+	if raw == "" || raw == "*" {
+		return 0, true
+	}
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.Atoi(raw[1 : len(raw)-1])
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
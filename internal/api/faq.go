@@ -8,9 +8,48 @@ import (
 )
 
 type FAQService interface {
-	ListActive(ctx context.Context) ([]domain.FAQ, error)
+	ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, error)
+	GetLocalized(ctx context.Context, id uuid.UUID, locales []string) (domain.FAQ, error)
+	List(ctx context.Context, filter domain.FAQListFilter, cursor string) (domain.FAQPage, error)
 	Create(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error)
 	Update(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error)
+	Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Search(ctx context.Context, in domain.SearchQuery) ([]domain.SearchResult, error)
+
+	ListTranslations(ctx context.Context, faqID uuid.UUID) ([]domain.Translation, error)
+	UpsertTranslation(ctx context.Context, in domain.UpsertTranslationInput) (domain.Translation, error)
+	DeleteTranslation(ctx context.Context, faqID uuid.UUID, locale string) error
+	ListMissingTranslations(ctx context.Context, locale string) ([]domain.FAQ, error)
+
+	ListRevisions(ctx context.Context, faqID uuid.UUID) ([]domain.Revision, error)
+	GetRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.Revision, error)
+	DiffRevisions(ctx context.Context, faqID uuid.UUID, from, to int) (domain.RevisionDiff, error)
+	RestoreRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.FAQ, error)
+
+	SaveDraft(ctx context.Context, id uuid.UUID, draft domain.FAQDraft) (domain.FAQ, error)
+	ChangeStatus(ctx context.Context, id uuid.UUID, to domain.FAQStatus) (domain.FAQ, error)
+	Publish(ctx context.Context, id uuid.UUID) (domain.FAQ, error)
+
+	ListDeleted(ctx context.Context) ([]domain.FAQ, error)
+	Restore(ctx context.Context, id uuid.UUID) (domain.FAQ, error)
+	Purge(ctx context.Context, id uuid.UUID) error
+	EmptyTrash(ctx context.Context) (int, error)
+
+	Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error)
+	Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error)
+
+	Export(ctx context.Context) ([]domain.FAQ, error)
+	Import(ctx context.Context, in domain.ImportInput) (domain.ImportResult, error)
+	Batch(ctx context.Context, ops []domain.BatchOperation, atomic bool) ([]domain.BatchResult, error)
+}
+
+type CategoryService interface {
+	List(ctx context.Context) ([]domain.Category, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error)
+	Create(ctx context.Context, in domain.CreateCategoryInput) (domain.Category, error)
+	Update(ctx context.Context, id uuid.UUID, in domain.UpdateCategoryInput) (domain.Category, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Group(ctx context.Context, faqs []domain.FAQ) ([]domain.FAQGroup, error)
 }
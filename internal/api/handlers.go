@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -12,11 +13,29 @@ import (
 )
 
 type Handler struct {
-	faqService FAQService
+	faqService       FAQService
+	categoryService  CategoryService
+	auditService     AuditService
+	listCacheControl string
+	tenantHosts      map[string]string
 }
 
-func NewHandler(faqService FAQService) *Handler {
-	return &Handler{faqService: faqService}
+type HandlerOption func(*Handler)
+
+// WithListCacheControl sets the Cache-Control header of the public FAQ
+// list. An empty value omits the header.
+func WithListCacheControl(value string) HandlerOption {
+	return func(h *Handler) {
+		h.listCacheControl = value
+	}
+}
+
+func NewHandler(faqService FAQService, categoryService CategoryService, opts ...HandlerOption) *Handler {
+	h := &Handler{faqService: faqService, categoryService: categoryService}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -25,13 +44,56 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	const base = "/api/v1/faqs"
-	if !strings.HasPrefix(r.URL.Path, base) {
-		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+	tenant, path, err := h.resolveTenant(r)
+	// the page and its script are loaded by browsers, which get their
+	// errors as HTML
+	page := path == "/faq" || path == "/faq/embed.js"
+	writeError := writeServiceError
+	if page {
+		writeError = writePageServiceError
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Add("Vary", "X-Tenant")
+
+	locales, err := negotiateLocales(r)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
+	ctx := domain.WithTenant(withLocales(r.Context(), locales), tenant)
+	// recorded in revision history
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		ctx = domain.WithActor(ctx, principal.Subject)
+	}
+	r = r.WithContext(ctx)
 
-	rest := strings.TrimPrefix(r.URL.Path, base)
+	const base = "/api/v1"
+	switch {
+	case page:
+		if r.Method != http.MethodGet {
+			writePageError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		if path == "/faq" {
+			h.handleFAQPage(w, r)
+		} else {
+			h.handleEmbedScript(w, r)
+		}
+	case strings.HasPrefix(path, base+"/faqs"):
+		h.serveFAQs(w, r, strings.TrimPrefix(path, base+"/faqs"))
+	case strings.HasPrefix(path, base+"/categories"):
+		h.serveCategories(w, r, strings.TrimPrefix(path, base+"/categories"))
+	case strings.HasPrefix(path, base+"/audit"):
+		h.serveAudit(w, r, strings.TrimPrefix(path, base+"/audit"))
+	default:
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+	}
+}
+
+func (h *Handler) serveFAQs(w http.ResponseWriter, r *http.Request, rest string) {
 	if rest == "" || rest == "/" {
 		switch r.Method {
 		case http.MethodGet:
@@ -50,19 +112,98 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
 		return
 	}
+	parts := strings.Split(strings.TrimPrefix(rest, "/"), "/")
 
-	idRaw := strings.TrimPrefix(rest, "/")
-	if idRaw == "" || strings.Contains(idRaw, "/") {
-		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+	switch strings.Join(parts, "/") {
+	case "search":
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleSearchFAQs(w, r)
+		return
+	case "translations/missing":
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleListMissingTranslations(w, r)
+		return
+	case "admin":
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleAdminListFAQs(w, r)
+		return
+	case "order":
+		if r.Method != http.MethodPut {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleReorderFAQs(w, r)
+		return
+	case "export":
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleExportFAQs(w, r)
+		return
+	case "import":
+		if r.Method != http.MethodPost {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleImportFAQs(w, r)
+		return
+	case "batch":
+		if r.Method != http.MethodPost {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleBatchFAQs(w, r)
+		return
+	case "jsonld":
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleFAQsJSONLD(w, r)
 		return
 	}
 
-	id, err := uuid.Parse(idRaw)
+	if parts[0] == "trash" {
+		h.serveTrash(w, r, parts[1:])
+		return
+	}
+	if parts[0] == "" {
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return
+	}
+	id, err := uuid.Parse(parts[0])
 	if err != nil {
 		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid id"})
 		return
 	}
 
+	if len(parts) > 1 {
+		switch parts[1] {
+		case "translations":
+			h.serveTranslations(w, r, id, parts[2:])
+			return
+		case "revisions":
+			h.serveRevisions(w, r, id, parts[2:])
+			return
+		}
+		if len(parts) == 2 {
+			h.serveFAQAction(w, r, id, parts[1])
+			return
+		}
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return
+	}
+
 	switch r.Method {
 	case http.MethodGet:
 		h.handleGetFAQ(w, r, id)
@@ -70,6 +211,9 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	case http.MethodPut:
 		h.handleUpdateFAQ(w, r, id)
 		return
+	case http.MethodPatch:
+		h.handlePatchFAQ(w, r, id)
+		return
 	case http.MethodDelete:
 		h.handleDeleteFAQ(w, r, id)
 		return
@@ -79,48 +223,179 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *Handler) serveFAQAction(w http.ResponseWriter, r *http.Request, id uuid.UUID, action string) {
+	routes := map[string]struct {
+		method string
+		handle func(http.ResponseWriter, *http.Request, uuid.UUID)
+	}{
+		"draft":   {http.MethodPut, h.handleSaveDraft},
+		"status":  {http.MethodPost, h.handleChangeStatus},
+		"publish": {http.MethodPost, h.handlePublishFAQ},
+		"restore": {http.MethodPost, h.handleRestoreFAQ},
+		"move":    {http.MethodPost, h.handleMoveFAQ},
+	}
+
+	route, ok := routes[action]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return
+	}
+	if r.Method != route.method {
+		writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+		return
+	}
+	route.handle(w, r, id)
+}
+
 // ListFAQs returns active FAQs ordered by position.
 //
 // @Summary      List FAQs
-// @Description  Get active FAQs ordered by position
+// @Description  Get active published FAQs ordered by position. With group=category the response is domain.FAQGroupListResponse with FAQs grouped into nested category sections. With Accept: application/ld+json the response is the domain.FAQPageDocument of GET /faqs/jsonld and group is ignored. Supports conditional requests with If-None-Match and If-Modified-Since.
 // @Tags         faqs
 // @Produce      json
+// @Produce      application/ld+json
+// @Param        category_id  query     string  false  "Category ID"
+// @Param        group        query     string  false  "Grouping mode"  Enums(category)
+// @Param        locale       query     string  false  "Preferred locale, takes precedence over Accept-Language"
+// @Param        Accept             header  string  false  "application/ld+json for schema.org structured data"
+// @Param        Accept-Language    header  string  false  "Preferred locales"
+// @Param        If-None-Match      header  string  false  "ETag of a cached response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified of a cached response"
 // @Success      200  {object}  domain.FAQListResponse
+// @Header       200  {string}  ETag           "Hash of the response body"
+// @Header       200  {string}  Last-Modified  "Latest change of the public FAQs, including removals from the list"
+// @Success      304  "Not modified"
+// @Failure      400  {object}  domain.ErrorResponse
 // @Failure      500  {object}  domain.ErrorResponse
 // @Router       /faqs [get]
 func (h *Handler) handleListFAQs(w http.ResponseWriter, r *http.Request) {
-	items, err := h.faqService.ListActive(r.Context())
+	query := r.URL.Query()
+
+	filter := domain.ListActiveFilter{Locales: localesFromContext(r.Context())}
+	if raw := query.Get("category_id"); raw != "" {
+		categoryID, err := uuid.Parse(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid category_id"})
+			return
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	group := query.Get("group")
+	if group != "" && group != "category" {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid group"})
+		return
+	}
+
+	active, err := h.faqService.ListActive(r.Context(), filter)
 	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, domain.ErrorResponse{Error: "internal error"})
+		writeServiceError(w, err)
+		return
+	}
+	w.Header().Add("Vary", "Accept-Language")
+	w.Header().Add("Vary", "Accept")
+
+	if prefersJSONLD(r) {
+		h.writeJSONLD(w, r, active)
+		return
+	}
+	if group == "category" {
+		groups, err := h.categoryService.Group(r.Context(), active.Items)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		h.writeCacheable(w, r, domain.DataResponse[[]domain.FAQGroupResponse]{Data: toFAQGroupResponses(groups)},
+			active.LastModified)
 		return
 	}
 
-	out := make([]domain.FAQListItemResponse, 0, len(items))
-	for _, it := range items {
-		out = append(out, domain.FAQListItemResponse{
-			ID:       it.ID,
-			Title:    it.Title,
-			Content:  it.Content,
-			Position: it.Position,
+	out := make([]domain.FAQListItemResponse, 0, len(active.Items))
+	for _, it := range active.Items {
+		out = append(out, toFAQListItemResponse(it))
+	}
+	h.writeCacheable(w, r, domain.DataResponse[[]domain.FAQListItemResponse]{Data: out}, active.LastModified)
+}
+
+// SearchFAQs runs a full-text search over active FAQs.
+//
+// @Summary      Search FAQs
+// @Description  Full-text search over title and content of active FAQs, ranked by relevance. Highlights are HTML-escaped with matches wrapped into <mark> tags.
+// @Tags         faqs
+// @Produce      json
+// @Param        q            query     string  true   "Search query (websearch syntax)"
+// @Param        lang         query     string  false  "Stemming language"  Enums(simple, en, english, ru, russian)
+// @Param        category_id  query     string  false  "Category ID"
+// @Param        limit        query     int     false  "Max results (1-100, default 20)"
+// @Success      200  {object}  domain.FAQSearchResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Router       /faqs/search [get]
+func (h *Handler) handleSearchFAQs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	in := domain.SearchQuery{
+		Query:    query.Get("q"),
+		Language: query.Get("lang"),
+	}
+	if raw := query.Get("category_id"); raw != "" {
+		categoryID, err := uuid.Parse(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid category_id"})
+			return
+		}
+		in.CategoryID = &categoryID
+	}
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid limit"})
+			return
+		}
+		in.Limit = limit
+	}
+
+	results, err := h.faqService.Search(r.Context(), in)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := make([]domain.FAQSearchItemResponse, 0, len(results))
+	for _, res := range results {
+		out = append(out, domain.FAQSearchItemResponse{
+			ID:               res.FAQ.ID,
+			CategoryID:       res.FAQ.CategoryID,
+			Title:            res.FAQ.Title,
+			Content:          res.FAQ.Content,
+			ContentFormat:    string(res.FAQ.ContentFormat),
+			ContentHTML:      renderContent(res.FAQ.ContentFormat, res.FAQ.Content),
+			Position:         res.FAQ.Position,
+			Rank:             res.Rank,
+			TitleHighlight:   res.TitleHighlight,
+			ContentHighlight: res.ContentHighlight,
 		})
 	}
-	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.FAQListItemResponse]{Data: out})
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.FAQSearchItemResponse]{Data: out})
 }
 
 // GetFAQ returns a FAQ by ID.
 //
 // @Summary      Get FAQ
-// @Description  Get one FAQ by id
+// @Description  Get one FAQ by id. FAQs outside their publish_at/expire_at window are not found.
 // @Tags         faqs
 // @Produce      json
-// @Param        id   path      string  true  "FAQ ID"
+// @Param        id      path      string  true   "FAQ ID"
+// @Param        locale  query     string  false  "Preferred locale, takes precedence over Accept-Language"
+// @Param        Accept-Language  header  string  false  "Preferred locales"
 // @Success      200  {object}  domain.FAQResponse
+// @Header       200  {string}  ETag  "Version of the FAQ"
 // @Failure      400  {object}  domain.ErrorResponse
 // @Failure      404  {object}  domain.ErrorResponse
 // @Failure      500  {object}  domain.ErrorResponse
 // @Router       /faqs/{id} [get]
 func (h *Handler) handleGetFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
-	faq, err := h.faqService.GetByID(r.Context(), id)
+	faq, err := h.faqService.GetLocalized(r.Context(), id, localesFromContext(r.Context()))
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
@@ -130,26 +405,26 @@ func (h *Handler) handleGetFAQ(w http.ResponseWriter, r *http.Request, id uuid.U
 		return
 	}
 
-	writeJSON(w, http.StatusOK, domain.DataResponse[domain.FAQFullResponse]{Data: domain.FAQFullResponse{
-		ID:       faq.ID,
-		Title:    faq.Title,
-		Content:  faq.Content,
-		Position: faq.Position,
-		IsActive: faq.IsActive,
-	}})
+	w.Header().Add("Vary", "Accept-Language")
+	w.Header().Set("Content-Language", faq.Locale)
+	writeFAQ(w, http.StatusOK, faq)
 }
 
 // CreateFAQ creates a new FAQ.
 //
 // @Summary      Create FAQ
-// @Description  Create new FAQ item
+// @Description  Create new FAQ item. Status defaults to published. Optional publish_at/expire_at limit when the FAQ is served.
 // @Tags         faqs
 // @Accept       json
 // @Produce      json
 // @Param        payload  body      domain.CreateFAQRequest  true  "FAQ payload"
 // @Success      201      {object}  domain.FAQResponse
 // @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
 // @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
 // @Router       /faqs [post]
 func (h *Handler) handleCreateFAQ(w http.ResponseWriter, r *http.Request) {
 	var req domain.CreateFAQRequest
@@ -163,41 +438,58 @@ func (h *Handler) handleCreateFAQ(w http.ResponseWriter, r *http.Request) {
 		isActive = *req.IsActive
 	}
 
+	var status domain.FAQStatus
+	if req.Status != nil {
+		status = domain.FAQStatus(*req.Status)
+	}
+
 	created, err := h.faqService.Create(r.Context(), domain.CreateFAQInput{
-		Title:    req.Title,
-		Content:  req.Content,
-		Position: req.Position,
-		IsActive: isActive,
+		CategoryID:    req.CategoryID,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: domain.ContentFormat(req.ContentFormat),
+		Position:      req.Position,
+		IsActive:      isActive,
+		Status:        status,
+		PublishAt:     req.PublishAt,
+		ExpireAt:      req.ExpireAt,
 	})
 	if err != nil {
 		writeServiceError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, domain.DataResponse[domain.FAQFullResponse]{Data: domain.FAQFullResponse{
-		ID:       created.ID,
-		Title:    created.Title,
-		Content:  created.Content,
-		Position: created.Position,
-		IsActive: created.IsActive,
-	}})
+	writeFAQ(w, http.StatusCreated, created)
 }
 
 // UpdateFAQ updates a FAQ.
 //
 // @Summary      Update FAQ
-// @Description  Update FAQ by id
+// @Description  Update FAQ by id. Omitted publish_at/expire_at clear the schedule. With If-Match the update only applies to the given version.
 // @Tags         faqs
 // @Accept       json
 // @Produce      json
-// @Param        id       path      string                 true  "FAQ ID"
-// @Param        payload  body      domain.UpdateFAQRequest true  "FAQ payload"
-// @Success      200      {object}  domain.FAQResponse
-// @Failure      400      {object}  domain.ErrorResponse
-// @Failure      404      {object}  domain.ErrorResponse
-// @Failure      500      {object}  domain.ErrorResponse
+// @Param        id        path      string                 true   "FAQ ID"
+// @Param        If-Match  header    string                 false  "ETag of the version being edited"
+// @Param        payload   body      domain.UpdateFAQRequest true   "FAQ payload"
+// @Success      200       {object}  domain.FAQResponse
+// @Header       200       {string}  ETag  "Version of the FAQ"
+// @Failure      400       {object}  domain.ErrorResponse
+// @Failure      401       {object}  domain.ErrorResponse
+// @Failure      403       {object}  domain.ErrorResponse
+// @Failure      404       {object}  domain.ErrorResponse
+// @Failure      412       {object}  domain.ErrorResponse
+// @Failure      500       {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
 // @Router       /faqs/{id} [put]
 func (h *Handler) handleUpdateFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeJSON(w, http.StatusPreconditionFailed, domain.ErrorResponse{Error: "precondition failed"})
+		return
+	}
+
 	var req domain.UpdateFAQRequest
 	if err := decodeJSON(w, r, &req); err != nil {
 		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
@@ -210,61 +502,137 @@ func (h *Handler) handleUpdateFAQ(w http.ResponseWriter, r *http.Request, id uui
 	}
 
 	updated, err := h.faqService.Update(r.Context(), id, domain.UpdateFAQInput{
-		Title:    req.Title,
-		Content:  req.Content,
-		Position: req.Position,
-		IsActive: isActive,
+		CategoryID:    req.CategoryID,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: domain.ContentFormat(req.ContentFormat),
+		Position:      req.Position,
+		IsActive:      isActive,
+		PublishAt:     req.PublishAt,
+		ExpireAt:      req.ExpireAt,
+		Version:       version,
 	})
 	if err != nil {
 		writeServiceError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, domain.DataResponse[domain.FAQFullResponse]{Data: domain.FAQFullResponse{
-		ID:       updated.ID,
-		Title:    updated.Title,
-		Content:  updated.Content,
-		Position: updated.Position,
-		IsActive: updated.IsActive,
-	}})
+	writeFAQ(w, http.StatusOK, updated)
 }
 
 // DeleteFAQ deletes a FAQ.
 //
 // @Summary      Delete FAQ
-// @Description  Delete FAQ by id (idempotent)
+// @Description  Move FAQ to the trash by id. It can be restored until purged.
 // @Tags         faqs
 // @Produce      json
 // @Param        id   path      string  true  "FAQ ID"
 // @Success      200  {object}  domain.MessageResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
 // @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
 // @Router       /faqs/{id} [delete]
 func (h *Handler) handleDeleteFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
-	err := h.faqService.Delete(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
-			return
-		}
-		writeJSON(w, http.StatusInternalServerError, domain.ErrorResponse{Error: "internal error"})
+	if err := h.faqService.Delete(r.Context(), id); err != nil {
+		writeServiceError(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, domain.MessageResponse{Message: "FAQ deleted successfully"})
+	writeJSON(w, http.StatusOK, domain.MessageResponse{Message: "FAQ moved to trash"})
+}
+
+func parseIDPath(w http.ResponseWriter, rest string) (uuid.UUID, bool) {
+	if !strings.HasPrefix(rest, "/") {
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return uuid.Nil, false
+	}
+
+	idRaw := strings.TrimPrefix(rest, "/")
+	if idRaw == "" || strings.Contains(idRaw, "/") {
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(idRaw)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid id"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func toFAQListItemResponse(faq domain.FAQ) domain.FAQListItemResponse {
+	return domain.FAQListItemResponse{
+		ID:            faq.ID,
+		CategoryID:    faq.CategoryID,
+		Locale:        faq.Locale,
+		Title:         faq.Title,
+		Content:       faq.Content,
+		ContentFormat: string(faq.ContentFormat),
+		ContentHTML:   renderContent(faq.ContentFormat, faq.Content),
+		Position:      faq.Position,
+	}
+}
+
+func toFAQFullResponse(faq domain.FAQ) domain.FAQFullResponse {
+	return domain.FAQFullResponse{
+		ID:            faq.ID,
+		CategoryID:    faq.CategoryID,
+		Locale:        faq.Locale,
+		Title:         faq.Title,
+		Content:       faq.Content,
+		ContentFormat: string(faq.ContentFormat),
+		ContentHTML:   renderContent(faq.ContentFormat, faq.Content),
+		Position:      faq.Position,
+		IsActive:      faq.IsActive,
+		Status:        string(faq.Status),
+		Draft:         faq.Draft,
+		PublishedAt:   faq.PublishedAt,
+		PublishAt:     faq.PublishAt,
+		ExpireAt:      faq.ExpireAt,
+		DeletedAt:     faq.DeletedAt,
+		Version:       faq.Version,
+	}
 }
 
 func writeServiceError(w http.ResponseWriter, err error) {
+	var conflict domain.VersionConflictError
+	if errors.As(err, &conflict) {
+		w.Header().Set("ETag", faqETag(conflict.Current))
+	}
+	status, resp := serviceErrorResponse(err)
+	writeJSON(w, status, resp)
+}
+
+// serviceErrorResponse maps a service error to its status and body.
+func serviceErrorResponse(err error) (int, domain.ErrorResponse) {
 	if errors.Is(err, domain.ErrNotFound) {
-		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
-		return
+		return http.StatusNotFound, domain.ErrorResponse{Error: "not found"}
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return http.StatusConflict, domain.ErrorResponse{Error: "faq has been modified concurrently, retry the request"}
+	}
+	if errors.Is(err, domain.ErrUnauthorized) {
+		return http.StatusUnauthorized, domain.ErrorResponse{Error: "unauthorized"}
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return http.StatusForbidden, domain.ErrorResponse{Error: "forbidden"}
 	}
 
 	var ve domain.ValidationError
 	if errors.As(err, &ve) {
-		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: ve.Error()})
-		return
+		return http.StatusBadRequest, domain.ErrorResponse{Error: ve.Error()}
+	}
+
+	var conflict domain.VersionConflictError
+	if errors.As(err, &conflict) {
+		return http.StatusPreconditionFailed, domain.ErrorResponse{Error: conflict.Error()}
 	}
 
-	writeJSON(w, http.StatusInternalServerError, domain.ErrorResponse{Error: "internal error"})
+	return http.StatusInternalServerError, domain.ErrorResponse{Error: "internal error"}
 }
 
 func decodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
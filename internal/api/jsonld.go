@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const jsonLDContentType = "application/ld+json"
+
+// FAQsJSONLD returns active FAQs as schema.org structured data.
+//
+// @Summary      FAQ structured data
+// @Description  Get active published FAQs as a schema.org FAQPage document in JSON-LD, in the order of the list. Answers are rendered to HTML and cut down to the tags search engines accept. GET /faqs returns the same document for Accept: application/ld+json. Supports conditional requests with If-None-Match and If-Modified-Since.
+// @Tags         faqs
+// @Produce      application/ld+json
+// @Param        category_id  query     string  false  "Category ID"
+// @Param        locale       query     string  false  "Preferred locale, takes precedence over Accept-Language"
+// @Param        Accept-Language    header  string  false  "Preferred locales"
+// @Success      200  {object}  domain.FAQPageDocument
+// @Header       200  {string}  ETag           "Hash of the response body"
+// @Header       200  {string}  Last-Modified  "Latest change of the public FAQs, including removals from the list"
+// @Success      304  "Not modified"
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Router       /faqs/jsonld [get]
+func (h *Handler) handleFAQsJSONLD(w http.ResponseWriter, r *http.Request) {
+	filter := domain.ListActiveFilter{Locales: localesFromContext(r.Context())}
+	if raw := r.URL.Query().Get("category_id"); raw != "" {
+		categoryID, err := uuid.Parse(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid category_id"})
+			return
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	active, err := h.faqService.ListActive(r.Context(), filter)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.Header().Add("Vary", "Accept-Language")
+	h.writeJSONLD(w, r, active)
+}
+
+// writeJSONLD writes the FAQs as a cacheable FAQPage document.
+func (h *Handler) writeJSONLD(w http.ResponseWriter, r *http.Request, active domain.ActiveFAQs) {
+	body, err := json.Marshal(toFAQPageDocument(active.Items))
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, domain.ErrorResponse{Error: "internal error"})
+		return
+	}
+	body = append(body, '\n')
+	h.writeCacheableBody(w, r, jsonLDContentType+"; charset=utf-8", body, active.LastModified)
+}
+
+func toFAQPageDocument(items []domain.FAQ) domain.FAQPageDocument {
+	doc := domain.FAQPageDocument{
+		Context:    "https://schema.org",
+		Type:       "FAQPage",
+		MainEntity: make([]domain.FAQPageQuestion, 0, len(items)),
+	}
+	for _, it := range items {
+		doc.MainEntity = append(doc.MainEntity, domain.FAQPageQuestion{
+			Type:       "Question",
+			Name:       it.Title,
+			InLanguage: it.Locale,
+			AcceptedAnswer: domain.FAQPageAnswer{
+				Type: "Answer",
+				Text: renderAnswer(it.ContentFormat, it.Content),
+			},
+		})
+	}
+	return doc
+}
+
+// prefersJSONLD reports whether Accept asks for JSON-LD at least as much
+// as for plain JSON. A missing Accept keeps plain JSON.
+func prefersJSONLD(r *http.Request) bool {
+	var ld, plain float64
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(raw, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case jsonLDContentType:
+			ld = max(ld, q)
+		case "application/json":
+			plain = max(plain, q)
+		}
+	}
+	return ld > 0 && ld >= plain
+}
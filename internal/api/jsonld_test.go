@@ -0,0 +1,162 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func decodeFAQPage(t *testing.T, body []byte) domain.FAQPageDocument {
+	t.Helper()
+	var doc domain.FAQPageDocument
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("decode FAQPage: %v: %s", err, body)
+	}
+	return doc
+}
+
+func question(name, lang, text string) domain.FAQPageQuestion {
+	return domain.FAQPageQuestion{
+		Type:           "Question",
+		Name:           name,
+		InLanguage:     lang,
+		AcceptedAnswer: domain.FAQPageAnswer{Type: "Answer", Text: text},
+	}
+}
+
+func TestFAQsJSONLD(t *testing.T) {
+	h, _ := newTestServer(t)
+	rec := do(t, h, http.MethodPost, "/api/v1/categories", `{"name": "Delivery", "slug": "delivery", "position": 1}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create category: status %d: %s", rec.Code, rec.Body)
+	}
+	var category struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &category); err != nil {
+		t.Fatalf("decode category: %v", err)
+	}
+
+	createFAQ(t, h, `{"title": "Returns", "content": "30 days", "position": 2, "is_active": true}`)
+	shipping := createFAQ(t, h, `{"category_id": "`+category.Data.ID+`", "title": "Shipping", "content": "**Two** days, see [rates](/rates)\n\n| a | b |\n|-|-|\n| 1 | 2 |", "content_format": "markdown", "position": 1, "is_active": true}`)
+	createFAQ(t, h, `{"title": "Hidden", "content": "C", "position": 3, "is_active": false}`)
+	rec = do(t, h, http.MethodPut, "/api/v1/faqs/"+shipping+"/translations/de", `{"title": "Versand", "content": "Zwei Tage"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("translate: status %d: %s", rec.Code, rec.Body)
+	}
+
+	shippingEN := question("Shipping", "en", "<p><strong>Two</strong> days, see <a href=\"/rates\">rates</a></p>\na\nb\n1\n2")
+	returnsEN := question("Returns", "en", "<p>30 days</p>")
+	tests := []struct {
+		name   string
+		target string
+		header []string
+		want   []domain.FAQPageQuestion
+	}{
+		{"all", "/api/v1/faqs/jsonld", nil, []domain.FAQPageQuestion{shippingEN, returnsEN}},
+		{"category", "/api/v1/faqs/jsonld?category_id=" + category.Data.ID, nil, []domain.FAQPageQuestion{shippingEN}},
+		{"locale", "/api/v1/faqs/jsonld?locale=de", nil, []domain.FAQPageQuestion{question("Versand", "de", "<p>Zwei Tage</p>"), returnsEN}},
+		{"Accept-Language", "/api/v1/faqs/jsonld", []string{"Accept-Language", "de-DE, en;q=0.5"}, []domain.FAQPageQuestion{question("Versand", "de", "<p>Zwei Tage</p>"), returnsEN}},
+		{"list", "/api/v1/faqs?locale=de&group=category&category_id=" + category.Data.ID, []string{"Accept", "application/ld+json"}, []domain.FAQPageQuestion{question("Versand", "de", "<p>Zwei Tage</p>")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, h, http.MethodGet, tt.target, "", tt.header...)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status %d: %s", rec.Code, rec.Body)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/ld+json; charset=utf-8" {
+				t.Errorf("Content-Type = %s", got)
+			}
+			doc := decodeFAQPage(t, rec.Body.Bytes())
+			want := domain.FAQPageDocument{Context: "https://schema.org", Type: "FAQPage", MainEntity: tt.want}
+			if !reflect.DeepEqual(doc, want) {
+				t.Errorf("document = %+v, want %+v", doc, want)
+			}
+		})
+	}
+
+	rec = do(t, h, http.MethodGet, "/api/v1/faqs/jsonld?category_id=1", "")
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid category_id: status %d, want 400", rec.Code)
+	}
+}
+
+func TestFAQsJSONLDEmpty(t *testing.T) {
+	h, _ := newTestServer(t)
+	rec := do(t, h, http.MethodGet, "/api/v1/faqs/jsonld", "")
+	if want := "{\"@context\":\"https://schema.org\",\"@type\":\"FAQPage\",\"mainEntity\":[]}\n"; rec.Body.String() != want {
+		t.Errorf("body = %s, want %s", rec.Body, want)
+	}
+}
+
+func TestFAQsJSONLDConditionalGet(t *testing.T) {
+	for _, target := range []string{"/api/v1/faqs/jsonld", "/api/v1/faqs"} {
+		t.Run(target, func(t *testing.T) {
+			h, clock := newTestServer(t)
+			createFAQ(t, h, `{"title": "Returns", "content": "30 days", "position": 1, "is_active": true}`)
+			id := createFAQ(t, h, `{"title": "Shipping", "content": "Two days", "position": 2, "is_active": true}`)
+
+			first := do(t, h, http.MethodGet, target, "", "Accept", "application/ld+json")
+			lastModified := first.Header().Get("Last-Modified")
+			if lastModified == "" {
+				t.Fatalf("no Last-Modified: %v", first.Header())
+			}
+			if rec := do(t, h, http.MethodGet, target, "", "Accept", "application/ld+json", "If-Modified-Since", lastModified); rec.Code != http.StatusNotModified {
+				t.Errorf("unchanged: status %d, want 304", rec.Code)
+			}
+
+			clock.Advance(time.Minute)
+			if rec := do(t, h, http.MethodPatch, "/api/v1/faqs/"+id, `{"is_active": false}`); rec.Code != http.StatusOK {
+				t.Fatalf("deactivate: status %d: %s", rec.Code, rec.Body)
+			}
+			rec := do(t, h, http.MethodGet, target, "", "Accept", "application/ld+json", "If-Modified-Since", lastModified)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("after deactivation: status %d, want 200", rec.Code)
+			}
+			if doc := decodeFAQPage(t, rec.Body.Bytes()); len(doc.MainEntity) != 1 {
+				t.Errorf("got %d questions, want 1", len(doc.MainEntity))
+			}
+		})
+	}
+}
+
+func TestListPrefersJSONLD(t *testing.T) {
+	h, _ := newTestServer(t)
+	tests := []struct {
+		accept string
+		jsonLD bool
+	}{
+		{"", false},
+		{"*/*", false},
+		{"application/json", false},
+		{"application/ld+json", true},
+		{"application/ld+json;q=0", false},
+		{"application/json, application/ld+json", true},
+		{"application/json;q=0.9, application/ld+json", true},
+		{"application/json, application/ld+json;q=0.9", false},
+		{"application/ld+json;q=0.5, application/json;q=0.5", true},
+		{"application/ld+json; profile=\"https://schema.org\"", true},
+		{"APPLICATION/LD+JSON", true},
+		{"application/ld+json;q=abc", false},
+		{"text/html, application/ld+json;q=0.1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			rec := do(t, h, http.MethodGet, "/api/v1/faqs", "", "Accept", tt.accept)
+			want := "application/json; charset=utf-8"
+			if tt.jsonLD {
+				want = "application/ld+json; charset=utf-8"
+			}
+			if got := rec.Header().Get("Content-Type"); got != want {
+				t.Errorf("Content-Type = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// AdminListFAQs returns a page of all FAQs.
+//
+// @Summary      Admin list FAQs
+// @Description  Get FAQs in any status, active or not, with filters, sorting and cursor pagination. Trashed FAQs are not included. Time ranges include *_from and exclude *_to. Pass next_cursor from the response as cursor to get the next page, keeping the same filters and sorting.
+// @Tags         faqs
+// @Produce      json
+// @Param        is_active     query     bool    false  "Active state"
+// @Param        status        query     string  false  "Workflow status"  Enums(draft, in_review, published, archived)
+// @Param        category_id   query     string  false  "Category ID"
+// @Param        created_from  query     string  false  "Created at or after (RFC 3339)"
+// @Param        created_to    query     string  false  "Created before (RFC 3339)"
+// @Param        updated_from  query     string  false  "Updated at or after (RFC 3339)"
+// @Param        updated_to    query     string  false  "Updated before (RFC 3339)"
+// @Param        q             query     string  false  "Substring of title or content"
+// @Param        sort          query     string  false  "Sort key (default position)"  Enums(position, created_at, updated_at, title)
+// @Param        order         query     string  false  "Sort direction (default asc)"  Enums(asc, desc)
+// @Param        limit         query     int     false  "Page size (1-100, default 20)"
+// @Param        cursor        query     string  false  "Cursor from the previous page"
+// @Success      200  {object}  domain.FAQPageResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/admin [get]
+func (h *Handler) handleAdminListFAQs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := domain.FAQListFilter{
+		Status: domain.FAQStatus(query.Get("status")),
+		Query:  query.Get("q"),
+		Sort:   domain.FAQSortKey(query.Get("sort")),
+	}
+	if raw := query.Get("is_active"); raw != "" {
+		isActive, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid is_active"})
+			return
+		}
+		filter.IsActive = &isActive
+	}
+	if raw := query.Get("category_id"); raw != "" {
+		categoryID, err := uuid.Parse(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid category_id"})
+			return
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	ranges := []struct {
+		name string
+		dest **time.Time
+	}{
+		{"created_from", &filter.CreatedFrom},
+		{"created_to", &filter.CreatedTo},
+		{"updated_from", &filter.UpdatedFrom},
+		{"updated_to", &filter.UpdatedTo},
+	}
+	for _, rng := range ranges {
+		t, ok := parseTimeParam(w, query, rng.name)
+		if !ok {
+			return
+		}
+		*rng.dest = t
+	}
+
+	switch query.Get("order") {
+	case "", "asc":
+	case "desc":
+		filter.Desc = true
+	default:
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid order"})
+		return
+	}
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid limit"})
+			return
+		}
+		filter.Limit = limit
+	}
+
+	page, err := h.faqService.List(r.Context(), filter, query.Get("cursor"))
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := make([]domain.FAQFullResponse, 0, len(page.Items))
+	for _, it := range page.Items {
+		out = append(out, toFAQFullResponse(it))
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.FAQFullResponse]{Data: out, NextCursor: page.NextCursor})
+}
+
+// parseTimeParam reads an optional RFC 3339 query parameter. It writes a
+// 400 response and returns false when the value is malformed.
+func parseTimeParam(w http.ResponseWriter, query url.Values, name string) (*time.Time, bool) {
+	raw := query.Get(name)
+	if raw == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid " + name})
+		return nil, false
+	}
+	return &t, true
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type localesKey struct{}
+
+func withLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+func localesFromContext(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
+}
+
+// negotiateLocales returns the locales preferred by the client: the
+// locale query parameter first, then Accept-Language ordered by quality.
+func negotiateLocales(r *http.Request) ([]string, error) {
+	out := make([]string, 0)
+	if raw := r.URL.Query().Get("locale"); raw != "" {
+		locale, ok := domain.NormalizeLocale(raw)
+		if !ok {
+			return nil, domain.ValidationError{Message: "invalid locale"}
+		}
+		out = append(out, locale)
+	}
+	return append(out, parseAcceptLanguage(r.Header.Get("Accept-Language"))...), nil
+}
+
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	items := make([]weighted, 0)
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		locale, ok := domain.NormalizeLocale(tag)
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || strings.TrimSpace(key) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				q = 0
+				break
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		items = append(items, weighted{locale: locale, q: q})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].q > items[j].q })
+
+	out := make([]string, 0, len(items))
+	for _, it := range items {
+		out = append(out, it.locale)
+	}
+	return out
+}
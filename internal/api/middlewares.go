@@ -2,13 +2,20 @@ package api
 
 import (
 	"log"
+	"net"
 	"net/http"
 	"runtime/debug"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/nightmaker00/accordion-go/internal/domain"
 )
 
+// maxRequestIDLen bounds request IDs taken from clients, which end up in
+// logs and the audit log.
+const maxRequestIDLen = 128
+
 type Middleware func(http.Handler) http.Handler
 
 func Chain(h http.Handler, m ...Middleware) http.Handler {
@@ -37,17 +44,65 @@ func RequestLogger() Middleware {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 			next.ServeHTTP(w, r)
-			log.Printf("%s %s %s", r.Method, r.URL.Path, time.Since(start))
+			log.Printf("%s %s %s %s", r.Method, r.URL.Path, time.Since(start), domain.RequestIDFromContext(r.Context()))
 		})
 	}
 }
 
+// RequestID keeps the X-Request-ID header of the request, or generates an
+// ID when it is missing or malformed, and echoes it in the response.
+func RequestID() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get("X-Request-ID")
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+			w.Header().Set("X-Request-ID", id)
+			next.ServeHTTP(w, r.WithContext(domain.WithRequestID(r.Context(), id)))
+		})
+	}
+}
+
+// ClientIP stores the address of the client in the request context. The
+// first X-Forwarded-For entry is used only with trustProxy, since clients
+// can set the header to anything when the server is not behind a proxy.
+func ClientIP(trustProxy bool) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				ip = r.RemoteAddr
+			}
+			if trustProxy {
+				if first, _, _ := strings.Cut(r.Header.Get("X-Forwarded-For"), ","); strings.TrimSpace(first) != "" {
+					ip = strings.TrimSpace(first)
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(domain.WithClientIP(r.Context(), ip)))
+		})
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, c := range id {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
 func CORS() Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, X-Actor, X-Request-ID, X-Tenant, If-Match, If-None-Match")
+			w.Header().Set("Access-Control-Expose-Headers", "ETag, Last-Modified, WWW-Authenticate, X-Request-ID")
 
 			if r.Method == http.MethodOptions {
 				w.WriteHeader(http.StatusNoContent)
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// ReorderFAQs rewrites the positions of a category.
+//
+// @Summary      Reorder FAQs
+// @Description  Set positions of all FAQs in a category to 1..n following the given order, in a single transaction. ids must list every FAQ of the category (omit category_id for FAQs without a category).
+// @Tags         faqs
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.ReorderFAQsRequest  true  "New order"
+// @Success      200      {object}  domain.FAQFullListResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/order [put]
+func (h *Handler) handleReorderFAQs(w http.ResponseWriter, r *http.Request) {
+	var req domain.ReorderFAQsRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	items, err := h.faqService.Reorder(r.Context(), domain.ReorderInput{CategoryID: req.CategoryID, IDs: req.IDs})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQFullList(w, items)
+}
+
+// MoveFAQ moves a FAQ before or after another one.
+//
+// @Summary      Move FAQ
+// @Description  Place a FAQ right before or after another FAQ of the same category and renumber the category
+// @Tags         faqs
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                 true  "FAQ ID"
+// @Param        payload  body      domain.MoveFAQRequest  true  "Target FAQ"
+// @Success      200      {object}  domain.FAQFullListResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      404      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/move [post]
+func (h *Handler) handleMoveFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	var req domain.MoveFAQRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if (req.Before == nil) == (req.After == nil) {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "exactly one of before and after is required"})
+		return
+	}
+
+	in := domain.MoveInput{ID: id}
+	if req.After != nil {
+		in.Target, in.After = *req.After, true
+	} else {
+		in.Target = *req.Before
+	}
+
+	items, err := h.faqService.Move(r.Context(), in)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQFullList(w, items)
+}
+
+func writeFAQFullList(w http.ResponseWriter, items []domain.FAQ) {
+	out := make([]domain.FAQFullResponse, 0, len(items))
+	for _, it := range items {
+		out = append(out, toFAQFullResponse(it))
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.FAQFullResponse]{Data: out})
+}
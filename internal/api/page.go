@@ -0,0 +1,230 @@
+package api
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+//go:embed web
+var webFiles embed.FS
+
+var (
+	pageTemplate = template.Must(template.ParseFS(webFiles, "web/faq.html"))
+	pageStyle    = template.CSS(mustReadWebFile("web/faq.css"))
+	pageScript   = template.JS(mustReadWebFile("web/accordion.js"))
+	// embedScript carries the keyboard handling itself, since scripts put
+	// into the page with innerHTML do not run
+	embedScript = mustReadWebFile("web/accordion.js") + "\n" + mustReadWebFile("web/embed.js")
+	// embedModified stands in for the modification time of the embedded
+	// script, which embed.FS does not keep
+	embedModified = time.Now()
+)
+
+func mustReadWebFile(name string) string {
+	data, err := webFiles.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+var (
+	themeColor  = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	themeLength = regexp.MustCompile(`^[0-9]{1,3}(\.[0-9]{1,2})?(px|rem|em)$`)
+)
+
+// themeParams are the query parameters of the page that override its CSS
+// variables. Values are checked against a pattern before they get into the
+// style sheet.
+var themeParams = []struct {
+	name     string
+	variable string
+	pattern  *regexp.Regexp
+}{
+	{"accent", "--faq-accent", themeColor},
+	{"color", "--faq-color", themeColor},
+	{"background", "--faq-background", themeColor},
+	{"border", "--faq-border", themeColor},
+	{"radius", "--faq-radius", themeLength},
+}
+
+type pageView struct {
+	Lang   string
+	Title  string
+	Embed  bool
+	Empty  bool
+	Style  template.CSS
+	Theme  template.CSS
+	Script template.JS
+	Items  []pageItem
+	Groups []pageGroup
+}
+
+type pageGroup struct {
+	Name     string
+	Level    int
+	Items    []pageItem
+	Children []pageGroup
+}
+
+type pageItem struct {
+	ID     uuid.UUID
+	Title  string
+	Answer template.HTML
+}
+
+// handleFAQPage renders the active FAQs as an HTML accordion. It takes
+// category_id, group and locale like the JSON list, title for the heading
+// and the theme parameters. embed=1 leaves out the document around the
+// accordion for embed.js.
+func (h *Handler) handleFAQPage(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := domain.ListActiveFilter{Locales: localesFromContext(r.Context())}
+	if raw := query.Get("category_id"); raw != "" {
+		categoryID, err := uuid.Parse(raw)
+		if err != nil {
+			writePageError(w, http.StatusBadRequest, "invalid category_id")
+			return
+		}
+		filter.CategoryID = &categoryID
+	}
+
+	group := query.Get("group")
+	if group != "" && group != "category" {
+		writePageError(w, http.StatusBadRequest, "invalid group")
+		return
+	}
+
+	var theme []string
+	for _, p := range themeParams {
+		value := query.Get(p.name)
+		if value == "" {
+			continue
+		}
+		if !p.pattern.MatchString(value) {
+			writePageError(w, http.StatusBadRequest, "invalid "+p.name)
+			return
+		}
+		if p.pattern == themeColor && !strings.HasPrefix(value, "#") {
+			value = "#" + value
+		}
+		theme = append(theme, p.variable+": "+value+";")
+	}
+
+	view := pageView{
+		Title:  "FAQ",
+		Embed:  query.Get("embed") == "1",
+		Style:  pageStyle,
+		Theme:  template.CSS(strings.Join(theme, " ")),
+		Script: pageScript,
+	}
+	if title := strings.TrimSpace(query.Get("title")); title != "" {
+		view.Title = title
+	}
+
+	active, err := h.faqService.ListActive(r.Context(), filter)
+	if err != nil {
+		writePageServiceError(w, err)
+		return
+	}
+	items := active.Items
+	w.Header().Add("Vary", "Accept-Language")
+	if len(items) > 0 {
+		view.Lang = items[0].Locale
+	}
+	view.Empty = len(items) == 0
+
+	if group == "category" {
+		groups, err := h.categoryService.Group(r.Context(), items)
+		if err != nil {
+			writePageServiceError(w, err)
+			return
+		}
+		view.Groups = toPageGroups(groups, 2)
+	} else {
+		view.Items = toPageItems(items)
+	}
+
+	name := "page"
+	if view.Embed {
+		name = "accordion"
+	}
+	var body bytes.Buffer
+	if err := pageTemplate.ExecuteTemplate(&body, name, view); err != nil {
+		writePageServiceError(w, err)
+		return
+	}
+	h.writeCacheableBody(w, r, "text/html; charset=utf-8", body.Bytes(), active.LastModified)
+}
+
+type pageError struct {
+	Status  int
+	Text    string
+	Message string
+}
+
+// writePageError writes an error of the page or its script as a minimal
+// HTML document, which browsers show as it is.
+func writePageError(w http.ResponseWriter, status int, message string) {
+	var body bytes.Buffer
+	view := pageError{Status: status, Text: http.StatusText(status), Message: message}
+	if err := pageTemplate.ExecuteTemplate(&body, "error", view); err != nil {
+		http.Error(w, message, status)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(body.Bytes())
+}
+
+// writePageServiceError is writeServiceError for the page.
+func writePageServiceError(w http.ResponseWriter, err error) {
+	status, resp := serviceErrorResponse(err)
+	writePageError(w, status, resp.Error)
+}
+
+// handleEmbedScript serves the script that puts the accordion into a
+// foreign page.
+func (h *Handler) handleEmbedScript(w http.ResponseWriter, r *http.Request) {
+	h.writeCacheableBody(w, r, "text/javascript; charset=utf-8", []byte(embedScript), embedModified)
+}
+
+// toPageGroups turns category groups into page sections. Groups without a
+// category keep their FAQs but get no heading.
+func toPageGroups(groups []domain.FAQGroup, level int) []pageGroup {
+	out := make([]pageGroup, 0, len(groups))
+	for _, g := range groups {
+		group := pageGroup{
+			Level:    level,
+			Items:    toPageItems(g.Items),
+			Children: toPageGroups(g.Children, level+1),
+		}
+		if g.Category != nil {
+			group.Name = g.Category.Name
+		}
+		out = append(out, group)
+	}
+	return out
+}
+
+func toPageItems(items []domain.FAQ) []pageItem {
+	out := make([]pageItem, 0, len(items))
+	for _, it := range items {
+		out = append(out, pageItem{
+			ID:    it.ID,
+			Title: it.Title,
+			// renderContent sanitizes the HTML, so it is safe to put as is
+			Answer: template.HTML(renderContent(it.ContentFormat, it.Content)),
+		})
+	}
+	return out
+}
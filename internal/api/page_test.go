@@ -0,0 +1,165 @@
+package api_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFAQPageTheme(t *testing.T) {
+	tests := []struct {
+		query string
+		// want is the theme rule of a valid query, or the error of an
+		// invalid one
+		want   string
+		status int
+	}{
+		{"accent=e11d48", ".faq { --faq-accent: #e11d48; }", http.StatusOK},
+		{"accent=%23fff&radius=0.5rem", ".faq { --faq-accent: #fff; --faq-radius: 0.5rem; }", http.StatusOK},
+		{"color=11223344&background=000&border=abcdef&radius=8px", ".faq { --faq-color: #11223344; --faq-background: #000; --faq-border: #abcdef; --faq-radius: 8px; }", http.StatusOK},
+		{"accent=red", "invalid accent", http.StatusBadRequest},
+		{"accent=%23fff%3B%7Dbody%7Bdisplay%3Anone", "invalid accent", http.StatusBadRequest},
+		{"background=url(x)", "invalid background", http.StatusBadRequest},
+		{"radius=8", "invalid radius", http.StatusBadRequest},
+		{"radius=1e3px", "invalid radius", http.StatusBadRequest},
+		{"radius=8px%3B%7D", "invalid radius", http.StatusBadRequest},
+		{"group=tag", "invalid group", http.StatusBadRequest},
+		{"category_id=1", "invalid category_id", http.StatusBadRequest},
+		{"locale=!", "invalid locale", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			h, _ := newTestServer(t)
+			rec := do(t, h, http.MethodGet, "/faq?"+tt.query, "")
+			if rec.Code != tt.status {
+				t.Fatalf("status %d, want %d: %s", rec.Code, tt.status, rec.Body)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+				t.Errorf("Content-Type = %s, want HTML", got)
+			}
+			if !strings.Contains(rec.Body.String(), tt.want) {
+				t.Errorf("body has no %q:\n%s", tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestFAQPageEscaping(t *testing.T) {
+	h, _ := newTestServer(t)
+	createFAQ(t, h, `{"title": "<script>alert(1)</script> & more", "content": "<img src=x onerror=alert(2)><b>bold</b>", "content_format": "html", "position": 1, "is_active": true}`)
+	createFAQ(t, h, `{"title": "Plain", "content": "a < b\n<i>not html</i>", "position": 2, "is_active": true}`)
+
+	rec := do(t, h, http.MethodGet, "/faq?title="+`%3C%2Ftitle%3E%3Cscript%3Ealert(3)%3C%2Fscript%3E`, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		"<title>&lt;/title&gt;&lt;script&gt;alert(3)&lt;/script&gt;</title>",
+		"<summary>&lt;script&gt;alert(1)&lt;/script&gt; &amp; more</summary>",
+		`<div class="faq-answer"><b>bold</b></div>`,
+		`<div class="faq-answer"><p>a &lt; b<br>` + "\n" + `&lt;i&gt;not html&lt;/i&gt;</p></div>`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("body has no %q", want)
+		}
+	}
+	for _, bad := range []string{"alert(1)</script>", "alert(3)</script>", "onerror", "<img"} {
+		if strings.Contains(body, bad) {
+			t.Errorf("body has %q:\n%s", bad, body)
+		}
+	}
+}
+
+func TestFAQPageErrors(t *testing.T) {
+	h, _ := newTestServer(t)
+	tests := []struct {
+		name   string
+		method string
+		target string
+		header []string
+		status int
+	}{
+		{"method", http.MethodPost, "/faq", nil, http.StatusMethodNotAllowed},
+		{"script method", http.MethodDelete, "/faq/embed.js", nil, http.StatusMethodNotAllowed},
+		{"tenant in path", http.MethodGet, "/t/Bad!/faq", nil, http.StatusBadRequest},
+		{"tenant header", http.MethodGet, "/faq", []string{"X-Tenant", "Bad!"}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, h, tt.method, tt.target, "", tt.header...)
+			if rec.Code != tt.status {
+				t.Fatalf("status %d, want %d", rec.Code, tt.status)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+				t.Errorf("Content-Type = %s, want HTML", got)
+			}
+			if !strings.HasPrefix(rec.Body.String(), "<!DOCTYPE html>") {
+				t.Errorf("body is not an HTML page: %s", rec.Body)
+			}
+		})
+	}
+
+	// the JSON API keeps its JSON errors
+	rec := do(t, h, http.MethodGet, "/api/v1/faqs?locale=!", "")
+	if got := rec.Header().Get("Content-Type"); rec.Code != http.StatusBadRequest || got != "application/json; charset=utf-8" {
+		t.Errorf("api error: status %d, Content-Type %s", rec.Code, got)
+	}
+}
+
+func TestFAQPageConditionalGet(t *testing.T) {
+	h, clock := newTestServer(t)
+	createFAQ(t, h, `{"title": "Returns", "content": "30 days", "position": 1, "is_active": true}`)
+	id := createFAQ(t, h, `{"title": "Shipping", "content": "Two days", "position": 2, "is_active": true}`)
+
+	for _, target := range []string{"/faq", "/faq?group=category&embed=1"} {
+		first := do(t, h, http.MethodGet, target, "")
+		lastModified := first.Header().Get("Last-Modified")
+		if rec := do(t, h, http.MethodGet, target, "", "If-Modified-Since", lastModified); rec.Code != http.StatusNotModified {
+			t.Errorf("%s: unchanged page: status %d, want 304", target, rec.Code)
+		}
+	}
+
+	first := do(t, h, http.MethodGet, "/faq", "")
+	lastModified := first.Header().Get("Last-Modified")
+	clock.Advance(time.Minute)
+	if rec := do(t, h, http.MethodDelete, "/api/v1/faqs/"+id, ""); rec.Code != http.StatusOK {
+		t.Fatalf("delete: status %d: %s", rec.Code, rec.Body)
+	}
+	rec := do(t, h, http.MethodGet, "/faq", "", "If-Modified-Since", lastModified)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("page after delete: status %d, want 200", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "Shipping") {
+		t.Errorf("page still has the deleted FAQ")
+	}
+}
+
+func TestEmbedScript(t *testing.T) {
+	h, _ := newTestServer(t)
+
+	rec := do(t, h, http.MethodGet, "/faq/embed.js", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d", rec.Code)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "text/javascript; charset=utf-8" {
+		t.Errorf("Content-Type = %s", got)
+	}
+	body := rec.Body.String()
+	// the accordion script comes along, since the embedded markup cannot
+	// bring it
+	for _, want := range []string{"function faqAccordion", "attachShadow", `searchParams.set("embed", "1")`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("script has no %q", want)
+		}
+	}
+	if rec.Header().Get("Cache-Control") == "" || rec.Header().Get("Last-Modified") == "" {
+		t.Errorf("script is not cacheable: %v", rec.Header())
+	}
+
+	again := do(t, h, http.MethodGet, "/t/acme/faq/embed.js", "", "If-None-Match", rec.Header().Get("ETag"))
+	if again.Code != http.StatusNotModified {
+		t.Errorf("get with the ETag under a tenant: status %d, want 304", again.Code)
+	}
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const (
+	mediaTypeMergePatch = "application/merge-patch+json"
+	mediaTypeJSONPatch  = "application/json-patch+json"
+)
+
+// jsonPatchOp is one operation of an RFC 6902 JSON Patch document.
+type jsonPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PatchFAQ partially updates a FAQ.
+//
+// @Summary      Patch FAQ
+// @Description  Change only the given fields of a FAQ. With Content-Type application/merge-patch+json (or application/json) the body is an RFC 7396 merge patch: {"is_active": false, "expire_at": null}. With application/json-patch+json it is an RFC 6902 JSON Patch supporting add, replace and remove on /category_id, /title, /content, /content_format, /position, /is_active, /publish_at and /expire_at. null or remove clears category_id, publish_at and expire_at.
+// @Tags         faqs
+// @Accept       json,application/merge-patch+json,application/json-patch+json
+// @Produce      json
+// @Param        id        path      string  true   "FAQ ID"
+// @Param        If-Match  header    string  false  "ETag of the version being edited"
+// @Param        payload   body      object  true   "Merge patch or JSON Patch"
+// @Success      200       {object}  domain.FAQResponse
+// @Header       200       {string}  ETag  "Version of the FAQ"
+// @Failure      400       {object}  domain.ErrorResponse
+// @Failure      401       {object}  domain.ErrorResponse
+// @Failure      403       {object}  domain.ErrorResponse
+// @Failure      404       {object}  domain.ErrorResponse
+// @Failure      412       {object}  domain.ErrorResponse
+// @Failure      415       {object}  domain.ErrorResponse
+// @Failure      500       {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id} [patch]
+func (h *Handler) handlePatchFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeJSON(w, http.StatusPreconditionFailed, domain.ErrorResponse{Error: "precondition failed"})
+		return
+	}
+
+	mediaType := "application/json"
+	if raw := r.Header.Get("Content-Type"); raw != "" {
+		parsed, _, err := mime.ParseMediaType(raw)
+		if err != nil {
+			writeJSON(w, http.StatusUnsupportedMediaType, domain.ErrorResponse{Error: "invalid content type"})
+			return
+		}
+		mediaType = parsed
+	}
+
+	var (
+		patch domain.FAQPatch
+		err   error
+	)
+	switch mediaType {
+	case mediaTypeMergePatch, "application/json":
+		patch, err = decodeMergePatch(w, r)
+	case mediaTypeJSONPatch:
+		patch, err = decodeJSONPatch(w, r)
+	default:
+		writeJSON(w, http.StatusUnsupportedMediaType, domain.ErrorResponse{Error: "unsupported content type"})
+		return
+	}
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+	patch.Version = version
+
+	updated, err := h.faqService.Patch(r.Context(), id, patch)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQ(w, http.StatusOK, updated)
+}
+
+func decodeMergePatch(w http.ResponseWriter, r *http.Request) (domain.FAQPatch, error) {
+	var doc map[string]json.RawMessage
+	if err := decodeJSON(w, r, &doc); err != nil {
+		return domain.FAQPatch{}, err
+	}
+	var patch domain.FAQPatch
+	for name, raw := range doc {
+		if err := setPatchMember(&patch, name, raw); err != nil {
+			return domain.FAQPatch{}, err
+		}
+	}
+	return patch, nil
+}
+
+func decodeJSONPatch(w http.ResponseWriter, r *http.Request) (domain.FAQPatch, error) {
+	var ops []jsonPatchOp
+	if err := decodeJSON(w, r, &ops); err != nil {
+		return domain.FAQPatch{}, err
+	}
+	var patch domain.FAQPatch
+	for i, op := range ops {
+		name, ok := strings.CutPrefix(op.Path, "/")
+		if !ok || name == "" || strings.Contains(name, "/") {
+			return domain.FAQPatch{}, fmt.Errorf("operation %d: invalid path %q", i, op.Path)
+		}
+		switch op.Op {
+		case "add", "replace":
+			if op.Value == nil {
+				return domain.FAQPatch{}, fmt.Errorf("operation %d: value is required", i)
+			}
+			if err := setPatchMember(&patch, name, op.Value); err != nil {
+				return domain.FAQPatch{}, fmt.Errorf("operation %d: %w", i, err)
+			}
+		case "remove":
+			if err := setPatchMember(&patch, name, json.RawMessage("null")); err != nil {
+				return domain.FAQPatch{}, fmt.Errorf("operation %d: %w", i, err)
+			}
+		default:
+			return domain.FAQPatch{}, fmt.Errorf("operation %d: unsupported op %q", i, op.Op)
+		}
+	}
+	return patch, nil
+}
+
+func setPatchMember(patch *domain.FAQPatch, name string, raw json.RawMessage) error {
+	switch name {
+	case "category_id":
+		return setPatchField(&patch.CategoryID, name, raw, true)
+	case "title":
+		return setPatchField(&patch.Title, name, raw, false)
+	case "content":
+		return setPatchField(&patch.Content, name, raw, false)
+	case "content_format":
+		return setPatchField(&patch.ContentFormat, name, raw, false)
+	case "position":
+		return setPatchField(&patch.Position, name, raw, false)
+	case "is_active":
+		return setPatchField(&patch.IsActive, name, raw, false)
+	case "publish_at":
+		return setPatchField(&patch.PublishAt, name, raw, true)
+	case "expire_at":
+		return setPatchField(&patch.ExpireAt, name, raw, true)
+	default:
+		return fmt.Errorf("unknown field %q", name)
+	}
+}
+
+func setPatchField[T any](field *domain.PatchField[T], name string, raw json.RawMessage, nullable bool) error {
+	if !nullable && bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return fmt.Errorf("%s cannot be null", name)
+	}
+	if err := json.Unmarshal(raw, &field.Value); err != nil {
+		return fmt.Errorf("invalid %s", name)
+	}
+	field.Set = true
+	return nil
+}
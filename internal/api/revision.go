@@ -0,0 +1,193 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (h *Handler) serveRevisions(w http.ResponseWriter, r *http.Request, faqID uuid.UUID, parts []string) {
+	switch {
+	case len(parts) == 0:
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleListRevisions(w, r, faqID)
+		return
+	case len(parts) == 1 && parts[0] == "diff":
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleDiffRevisions(w, r, faqID)
+		return
+	case len(parts) > 2 || (len(parts) == 2 && parts[1] != "restore"):
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+		return
+	}
+
+	number, err := strconv.Atoi(parts[0])
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid revision"})
+		return
+	}
+
+	if len(parts) == 2 {
+		if r.Method != http.MethodPost {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleRestoreRevision(w, r, faqID, number)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+		return
+	}
+	h.handleGetRevision(w, r, faqID, number)
+}
+
+// ListRevisions returns the history of a FAQ.
+//
+// @Summary      List revisions
+// @Description  Get all revisions of a FAQ, newest first. History is kept after the FAQ is deleted.
+// @Tags         revisions
+// @Produce      json
+// @Param        id   path      string  true  "FAQ ID"
+// @Success      200  {object}  domain.RevisionListResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/revisions [get]
+func (h *Handler) handleListRevisions(w http.ResponseWriter, r *http.Request, faqID uuid.UUID) {
+	items, err := h.faqService.ListRevisions(r.Context(), faqID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := make([]domain.RevisionFullResponse, 0, len(items))
+	for _, it := range items {
+		out = append(out, toRevisionFullResponse(it))
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.RevisionFullResponse]{Data: out})
+}
+
+// GetRevision returns one revision of a FAQ.
+//
+// @Summary      Get revision
+// @Description  Get one revision of a FAQ with its full snapshot
+// @Tags         revisions
+// @Produce      json
+// @Param        id        path      string  true  "FAQ ID"
+// @Param        revision  path      int     true  "Revision number"
+// @Success      200       {object}  domain.RevisionResponse
+// @Failure      400       {object}  domain.ErrorResponse
+// @Failure      401       {object}  domain.ErrorResponse
+// @Failure      403       {object}  domain.ErrorResponse
+// @Failure      404       {object}  domain.ErrorResponse
+// @Failure      500       {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/revisions/{revision} [get]
+func (h *Handler) handleGetRevision(w http.ResponseWriter, r *http.Request, faqID uuid.UUID, number int) {
+	rev, err := h.faqService.GetRevision(r.Context(), faqID, number)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[domain.RevisionFullResponse]{Data: toRevisionFullResponse(rev)})
+}
+
+// DiffRevisions compares two revisions of a FAQ.
+//
+// @Summary      Diff revisions
+// @Description  Get fields changed between two revisions of a FAQ
+// @Tags         revisions
+// @Produce      json
+// @Param        id    path      string  true  "FAQ ID"
+// @Param        from  query     int     true  "Older revision number"
+// @Param        to    query     int     true  "Newer revision number"
+// @Success      200   {object}  domain.RevisionDiffResponse
+// @Failure      400   {object}  domain.ErrorResponse
+// @Failure      401   {object}  domain.ErrorResponse
+// @Failure      403   {object}  domain.ErrorResponse
+// @Failure      404   {object}  domain.ErrorResponse
+// @Failure      500   {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/revisions/diff [get]
+func (h *Handler) handleDiffRevisions(w http.ResponseWriter, r *http.Request, faqID uuid.UUID) {
+	from, err := strconv.Atoi(r.URL.Query().Get("from"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid from"})
+		return
+	}
+	to, err := strconv.Atoi(r.URL.Query().Get("to"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid to"})
+		return
+	}
+
+	diff, err := h.faqService.DiffRevisions(r.Context(), faqID, from, to)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := domain.RevisionDiffFullResponse{
+		FAQID:   diff.FAQID,
+		From:    diff.From,
+		To:      diff.To,
+		Changes: make([]domain.RevisionChangeResponse, 0, len(diff.Changes)),
+	}
+	for _, c := range diff.Changes {
+		out.Changes = append(out.Changes, domain.RevisionChangeResponse{Field: c.Field, From: c.From, To: c.To})
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[domain.RevisionDiffFullResponse]{Data: out})
+}
+
+// RestoreRevision restores an old revision of a FAQ.
+//
+// @Summary      Restore revision
+// @Description  Make an old revision the current version of the FAQ, recreating it if it was deleted
+// @Tags         revisions
+// @Produce      json
+// @Param        id        path      string  true  "FAQ ID"
+// @Param        revision  path      int     true  "Revision number"
+// @Success      200       {object}  domain.FAQResponse
+// @Failure      400       {object}  domain.ErrorResponse
+// @Failure      401       {object}  domain.ErrorResponse
+// @Failure      403       {object}  domain.ErrorResponse
+// @Failure      404       {object}  domain.ErrorResponse
+// @Failure      409       {object}  domain.ErrorResponse
+// @Failure      500       {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/revisions/{revision}/restore [post]
+func (h *Handler) handleRestoreRevision(w http.ResponseWriter, r *http.Request, faqID uuid.UUID, number int) {
+	restored, err := h.faqService.RestoreRevision(r.Context(), faqID, number)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQ(w, http.StatusOK, restored)
+}
+
+func toRevisionFullResponse(rev domain.Revision) domain.RevisionFullResponse {
+	return domain.RevisionFullResponse{
+		FAQID:     rev.FAQID,
+		Revision:  rev.Number,
+		Action:    string(rev.Action),
+		Actor:     rev.Actor,
+		CreatedAt: rev.CreatedAt,
+		Snapshot:  rev.Snapshot,
+	}
+}
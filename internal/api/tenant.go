@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// tenantPrefix starts paths that name their tenant, as in
+// /t/acme/api/v1/faqs.
+const tenantPrefix = "/t/"
+
+// WithTenantHosts maps host names, without a port, to the tenant served
+// under them.
+func WithTenantHosts(hosts map[string]string) HandlerOption {
+	return func(h *Handler) {
+		h.tenantHosts = make(map[string]string, len(hosts))
+		for host, tenant := range hosts {
+			h.tenantHosts[strings.ToLower(host)] = tenant
+		}
+	}
+}
+
+// resolveTenant picks the tenant of a request from the path prefix, the
+// X-Tenant header or the host name, in that order, falling back to the
+// default tenant. It returns the path with the prefix removed, also along
+// with an invalid tenant.
+func (h *Handler) resolveTenant(r *http.Request) (string, string, error) {
+	path := r.URL.Path
+	if rest, ok := strings.CutPrefix(path, tenantPrefix); ok {
+		tenant, tail, _ := strings.Cut(rest, "/")
+		if !domain.ValidTenant(tenant) {
+			return "", "/" + tail, domain.ValidationError{Message: "tenant is invalid"}
+		}
+		return tenant, "/" + tail, nil
+	}
+
+	if tenant := strings.TrimSpace(r.Header.Get("X-Tenant")); tenant != "" {
+		if !domain.ValidTenant(tenant) {
+			return "", path, domain.ValidationError{Message: "tenant is invalid"}
+		}
+		return tenant, path, nil
+	}
+
+	host := r.Host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	if tenant, ok := h.tenantHosts[strings.ToLower(host)]; ok {
+		return tenant, path, nil
+	}
+	return domain.DefaultTenant, path, nil
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// maxImportBytes bounds the size of an import file.
+const maxImportBytes = 10 << 20 // 10MB
+
+// ExportFAQs returns every FAQ as a file.
+//
+// @Summary      Export FAQs
+// @Description  Download every FAQ outside the trash, including inactive and unpublished ones, with timestamps. JSON and YAML hold an object with a faqs list, CSV has one FAQ per row under a header, Markdown has a section per FAQ with its fields in an HTML comment. The file can be imported again.
+// @Tags         transfer
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/yaml
+// @Produce      text/markdown
+// @Param        format  query     string  false  "File format (default json)"  Enums(json, csv, yaml, md)
+// @Success      200     {object}  domain.FAQDump
+// @Failure      400     {object}  domain.ErrorResponse
+// @Failure      401     {object}  domain.ErrorResponse
+// @Failure      403     {object}  domain.ErrorResponse
+// @Failure      500     {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/export [get]
+func (h *Handler) handleExportFAQs(w http.ResponseWriter, r *http.Request) {
+	format := domain.FormatJSON
+	if raw := r.URL.Query().Get("format"); raw != "" {
+		format = domain.TransferFormat(raw)
+	}
+	if !format.Valid() {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "format must be json, csv, yaml or md"})
+		return
+	}
+
+	items, err := h.faqService.Export(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	records := make([]domain.FAQRecord, 0, len(items))
+	for _, it := range items {
+		records = append(records, domain.NewFAQRecord(it))
+	}
+
+	// encoded up front, so that a failure still gets a proper error response
+	var body bytes.Buffer
+	if err := encodeFAQRecords(&body, format, records, time.Now().UTC()); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", transferContentTypes[format]+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="faqs.`+string(format)+`"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body.Bytes())
+}
+
+// ImportFAQs stores the FAQs of a file.
+//
+// @Summary      Import FAQs
+// @Description  Upload a file in the export format. The format is taken from the format parameter or else from Content-Type. With strategy upsert (default) rows with the id of an existing FAQ update it and the other rows create FAQs; replace also moves every FAQ missing from the file to the trash and rejects an empty file. published_at, version and the timestamps in the file are ignored. All rows are checked and stored in one transaction: when any row is rejected nothing is stored and the response lists every rejected row with 422. dry_run reports the outcome without storing anything.
+// @Tags         transfer
+// @Accept       json
+// @Accept       text/csv
+// @Accept       application/yaml
+// @Accept       text/markdown
+// @Produce      json
+// @Param        format    query     string  false  "File format"  Enums(json, csv, yaml, md)
+// @Param        strategy  query     string  false  "Import strategy (default upsert)"  Enums(upsert, replace)
+// @Param        dry_run   query     bool    false  "Only check the file"
+// @Param        payload   body      domain.FAQDump  true  "File to import"
+// @Success      200       {object}  domain.ImportResponse
+// @Failure      400       {object}  domain.ErrorResponse
+// @Failure      401       {object}  domain.ErrorResponse
+// @Failure      403       {object}  domain.ErrorResponse
+// @Failure      422       {object}  domain.ImportResponse
+// @Failure      500       {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/import [post]
+func (h *Handler) handleImportFAQs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format, ok := importFormat(r)
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "format must be json, csv, yaml or md"})
+		return
+	}
+	in := domain.ImportInput{Strategy: domain.ImportStrategy(query.Get("strategy"))}
+	if raw := query.Get("dry_run"); raw != "" {
+		dryRun, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid dry_run"})
+			return
+		}
+		in.DryRun = dryRun
+	}
+
+	records, err := decodeFAQRecords(http.MaxBytesReader(w, r.Body, maxImportBytes), format)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, domain.ErrorResponse{Error: "file is too large"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+	for i, rec := range records {
+		in.Items = append(in.Items, rec.ImportItem(i+1))
+	}
+
+	result, err := h.faqService.Import(r.Context(), in)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := domain.ImportResultResponse{
+		DryRun:    in.DryRun,
+		Created:   result.Count(domain.AuditCreate),
+		Updated:   result.Count(domain.AuditUpdate),
+		Deleted:   result.Count(domain.AuditDelete),
+		Unchanged: result.Unchanged,
+		Errors:    make([]domain.ImportErrorResponse, 0, len(result.Errors)),
+	}
+	for _, e := range result.Errors {
+		out.Errors = append(out.Errors, domain.ImportErrorResponse{Row: e.Row, Error: e.Message})
+	}
+	status := http.StatusOK
+	if len(out.Errors) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	writeJSON(w, status, domain.ImportResponse{Data: out})
+}
+
+// importFormat takes the format of an import from the query or else from
+// Content-Type, defaulting to JSON.
+func importFormat(r *http.Request) (domain.TransferFormat, bool) {
+	if raw := r.URL.Query().Get("format"); raw != "" {
+		format := domain.TransferFormat(raw)
+		return format, format.Valid()
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return domain.FormatJSON, true
+	}
+	for format, contentType := range transferContentTypes {
+		if mediaType == contentType {
+			return format, true
+		}
+	}
+	switch mediaType {
+	case "application/x-yaml", "text/yaml":
+		return domain.FormatYAML, true
+	}
+	return domain.FormatJSON, true
+}
@@ -0,0 +1,306 @@
+package api
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"gopkg.in/yaml.v2"
+)
+
+// transferContentTypes are the media types of the transfer formats. On
+// import a matching Content-Type selects the format when the query does
+// not name one.
+var transferContentTypes = map[domain.TransferFormat]string{
+	domain.FormatJSON:     "application/json",
+	domain.FormatCSV:      "text/csv",
+	domain.FormatYAML:     "application/yaml",
+	domain.FormatMarkdown: "text/markdown",
+}
+
+// csvColumns is the header of a CSV export. An import may list any subset
+// of them in any order.
+var csvColumns = []string{
+	"id", "category_id", "title", "content", "content_format", "position", "is_active", "status",
+	"publish_at", "expire_at", "published_at", "version", "created_at", "updated_at",
+}
+
+func encodeFAQRecords(w io.Writer, format domain.TransferFormat, records []domain.FAQRecord, exportedAt time.Time) error {
+	switch format {
+	case domain.FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(domain.FAQDump{ExportedAt: exportedAt, FAQs: records})
+	case domain.FormatYAML:
+		return yaml.NewEncoder(w).Encode(domain.FAQDump{ExportedAt: exportedAt, FAQs: records})
+	case domain.FormatCSV:
+		return encodeCSV(w, records)
+	case domain.FormatMarkdown:
+		return encodeMarkdown(w, records)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// decodeFAQRecords reads a file in one of the transfer formats. Errors
+// name the record they were found in.
+func decodeFAQRecords(r io.Reader, format domain.TransferFormat) ([]domain.FAQRecord, error) {
+	switch format {
+	case domain.FormatJSON:
+		var dump domain.FAQDump
+		dec := json.NewDecoder(r)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&dump); err != nil {
+			return nil, err
+		}
+		if err := dec.Decode(&struct{}{}); err != io.EOF {
+			return nil, errors.New("invalid json")
+		}
+		return dump.FAQs, nil
+	case domain.FormatYAML:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		var dump domain.FAQDump
+		if err := yaml.UnmarshalStrict(data, &dump); err != nil {
+			return nil, err
+		}
+		return dump.FAQs, nil
+	case domain.FormatCSV:
+		return decodeCSV(r)
+	case domain.FormatMarkdown:
+		return decodeMarkdown(r)
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+func encodeCSV(w io.Writer, records []domain.FAQRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, rec := range records {
+		row := []string{
+			formatOptionalUUID(rec.ID), formatOptionalUUID(rec.CategoryID), rec.Title, rec.Content, string(rec.ContentFormat),
+			strconv.Itoa(rec.Position), formatOptionalBool(rec.IsActive), string(rec.Status),
+			formatOptionalTime(rec.PublishAt), formatOptionalTime(rec.ExpireAt), formatOptionalTime(rec.PublishedAt),
+			strconv.Itoa(rec.Version), formatOptionalTime(rec.CreatedAt), formatOptionalTime(rec.UpdatedAt),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func decodeCSV(r io.Reader) ([]domain.FAQRecord, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv header is missing")
+		}
+		return nil, err
+	}
+	known := make(map[string]bool, len(csvColumns))
+	for _, name := range csvColumns {
+		known[name] = true
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if !known[name] {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		index[name] = i
+	}
+
+	out := make([]domain.FAQRecord, 0)
+	for row := 1; ; row++ {
+		fields, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// title and content are kept as they are, the rest is trimmed
+		field := func(name string) string {
+			if i, ok := index[name]; ok {
+				return fields[i]
+			}
+			return ""
+		}
+		get := func(name string) string {
+			return strings.TrimSpace(field(name))
+		}
+
+		rec := domain.FAQRecord{
+			Title:         field("title"),
+			Content:       field("content"),
+			ContentFormat: domain.ContentFormat(get("content_format")),
+			Status:        domain.FAQStatus(get("status")),
+		}
+		if rec.ID, err = parseOptionalUUID(get("id")); err != nil {
+			return nil, fmt.Errorf("record %d: invalid id", row)
+		}
+		if rec.CategoryID, err = parseOptionalUUID(get("category_id")); err != nil {
+			return nil, fmt.Errorf("record %d: invalid category_id", row)
+		}
+		if raw := get("position"); raw != "" {
+			if rec.Position, err = strconv.Atoi(raw); err != nil {
+				return nil, fmt.Errorf("record %d: invalid position", row)
+			}
+		}
+		if raw := get("is_active"); raw != "" {
+			isActive, err := strconv.ParseBool(raw)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: invalid is_active", row)
+			}
+			rec.IsActive = &isActive
+		}
+		if rec.PublishAt, err = parseOptionalTime(get("publish_at")); err != nil {
+			return nil, fmt.Errorf("record %d: invalid publish_at", row)
+		}
+		if rec.ExpireAt, err = parseOptionalTime(get("expire_at")); err != nil {
+			return nil, fmt.Errorf("record %d: invalid expire_at", row)
+		}
+		out = append(out, rec)
+	}
+}
+
+// A Markdown export is a level 2 heading with the title of every FAQ,
+// followed by an HTML comment holding the other fields as JSON and then
+// the content:
+//
+//	## How long does delivery take?
+//	<!-- faq {"id":"…","position":1,"status":"published"} -->
+//
+//	Two to five working days.
+const (
+	markdownTitlePrefix = "## "
+	markdownMetaPrefix  = "<!-- faq "
+	markdownMetaSuffix  = " -->"
+)
+
+// markdownMeta is the comment of a FAQ in Markdown. Its empty title and
+// content hide those of the record, which live in the heading and body.
+type markdownMeta struct {
+	domain.FAQRecord
+	Title   string `json:"title,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+func encodeMarkdown(w io.Writer, records []domain.FAQRecord) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("# FAQ\n")
+	for _, rec := range records {
+		meta, err := json.Marshal(markdownMeta{FAQRecord: rec})
+		if err != nil {
+			return err
+		}
+		title := strings.Join(strings.Fields(rec.Title), " ")
+		fmt.Fprintf(bw, "\n%s%s\n%s%s%s\n\n%s\n", markdownTitlePrefix, title, markdownMetaPrefix, meta, markdownMetaSuffix,
+			strings.Trim(rec.Content, "\n"))
+	}
+	return bw.Flush()
+}
+
+func decodeMarkdown(r io.Reader) ([]domain.FAQRecord, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	isStart := func(i int) bool {
+		return i+1 < len(lines) && strings.HasPrefix(lines[i], markdownTitlePrefix) &&
+			strings.HasPrefix(lines[i+1], markdownMetaPrefix) && strings.HasSuffix(lines[i+1], markdownMetaSuffix)
+	}
+
+	out := make([]domain.FAQRecord, 0)
+	for i := 0; i < len(lines); {
+		if !isStart(i) {
+			i++
+			continue
+		}
+		row := len(out) + 1
+		raw := strings.TrimSuffix(strings.TrimPrefix(lines[i+1], markdownMetaPrefix), markdownMetaSuffix)
+		dec := json.NewDecoder(strings.NewReader(raw))
+		dec.DisallowUnknownFields()
+		var meta markdownMeta
+		if err := dec.Decode(&meta); err != nil {
+			return nil, fmt.Errorf("record %d: %v", row, err)
+		}
+
+		end := i + 2
+		for end < len(lines) && !isStart(end) {
+			end++
+		}
+		rec := meta.FAQRecord
+		rec.Title = strings.TrimSpace(strings.TrimPrefix(lines[i], markdownTitlePrefix))
+		rec.Content = strings.Trim(strings.Join(lines[i+2:end], "\n"), "\n")
+		out = append(out, rec)
+		i = end
+	}
+	// anything but a top heading in a file without FAQs is a format mistake
+	if len(out) == 0 {
+		for _, line := range lines {
+			if strings.TrimSpace(line) != "" && !strings.HasPrefix(line, "# ") {
+				return nil, errors.New("no faqs found")
+			}
+		}
+	}
+	return out, nil
+}
+
+func formatOptionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func formatOptionalBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func parseOptionalUUID(raw string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func parseOptionalTime(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (h *Handler) serveTranslations(w http.ResponseWriter, r *http.Request, faqID uuid.UUID, parts []string) {
+	switch {
+	case len(parts) == 0:
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handleListTranslations(w, r, faqID)
+	case len(parts) == 1 && parts[0] != "":
+		switch r.Method {
+		case http.MethodPut:
+			h.handleUpsertTranslation(w, r, faqID, parts[0])
+		case http.MethodDelete:
+			h.handleDeleteTranslation(w, r, faqID, parts[0])
+		default:
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+		}
+	default:
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+	}
+}
+
+// ListTranslations returns all translations of a FAQ.
+//
+// @Summary      List translations
+// @Description  Get all translations of a FAQ ordered by locale
+// @Tags         translations
+// @Produce      json
+// @Param        id   path      string  true  "FAQ ID"
+// @Success      200  {object}  domain.TranslationListResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/translations [get]
+func (h *Handler) handleListTranslations(w http.ResponseWriter, r *http.Request, faqID uuid.UUID) {
+	items, err := h.faqService.ListTranslations(r.Context(), faqID)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	out := make([]domain.TranslationFullResponse, 0, len(items))
+	for _, it := range items {
+		out = append(out, toTranslationFullResponse(it))
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[[]domain.TranslationFullResponse]{Data: out})
+}
+
+// UpsertTranslation creates or updates a FAQ translation.
+//
+// @Summary      Create or update translation
+// @Description  Create or replace the translation of a FAQ for a locale
+// @Tags         translations
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                           true  "FAQ ID"
+// @Param        locale   path      string                           true  "Locale (BCP 47 tag)"
+// @Param        payload  body      domain.UpsertTranslationRequest  true  "Translation payload"
+// @Success      200      {object}  domain.TranslationResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      404      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/translations/{locale} [put]
+func (h *Handler) handleUpsertTranslation(w http.ResponseWriter, r *http.Request, faqID uuid.UUID, locale string) {
+	var req domain.UpsertTranslationRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	saved, err := h.faqService.UpsertTranslation(r.Context(), domain.UpsertTranslationInput{
+		FAQID:   faqID,
+		Locale:  locale,
+		Title:   req.Title,
+		Content: req.Content,
+	})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[domain.TranslationFullResponse]{Data: toTranslationFullResponse(saved)})
+}
+
+// DeleteTranslation deletes a FAQ translation.
+//
+// @Summary      Delete translation
+// @Description  Delete the translation of a FAQ for a locale
+// @Tags         translations
+// @Produce      json
+// @Param        id      path      string  true  "FAQ ID"
+// @Param        locale  path      string  true  "Locale (BCP 47 tag)"
+// @Success      200     {object}  domain.MessageResponse
+// @Failure      400     {object}  domain.ErrorResponse
+// @Failure      401     {object}  domain.ErrorResponse
+// @Failure      403     {object}  domain.ErrorResponse
+// @Failure      404     {object}  domain.ErrorResponse
+// @Failure      500     {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/translations/{locale} [delete]
+func (h *Handler) handleDeleteTranslation(w http.ResponseWriter, r *http.Request, faqID uuid.UUID, locale string) {
+	if err := h.faqService.DeleteTranslation(r.Context(), faqID, locale); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.MessageResponse{Message: "Translation deleted successfully"})
+}
+
+// ListMissingTranslations reports FAQs without a translation.
+//
+// @Summary      List missing translations
+// @Description  Get all FAQs, active or not, that have no translation for the locale
+// @Tags         translations
+// @Produce      json
+// @Param        locale  query     string  true  "Locale (BCP 47 tag)"
+// @Success      200     {object}  domain.FAQFullListResponse
+// @Failure      400     {object}  domain.ErrorResponse
+// @Failure      401     {object}  domain.ErrorResponse
+// @Failure      403     {object}  domain.ErrorResponse
+// @Failure      500     {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/translations/missing [get]
+func (h *Handler) handleListMissingTranslations(w http.ResponseWriter, r *http.Request) {
+	items, err := h.faqService.ListMissingTranslations(r.Context(), r.URL.Query().Get("locale"))
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQFullList(w, items)
+}
+
+func toTranslationFullResponse(t domain.Translation) domain.TranslationFullResponse {
+	return domain.TranslationFullResponse{
+		FAQID:   t.FAQID,
+		Locale:  t.Locale,
+		Title:   t.Title,
+		Content: t.Content,
+	}
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (h *Handler) serveTrash(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0:
+		switch r.Method {
+		case http.MethodGet:
+			h.handleListTrash(w, r)
+		case http.MethodDelete:
+			h.handleEmptyTrash(w, r)
+		default:
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+		}
+	case len(parts) == 1 && parts[0] != "":
+		id, err := uuid.Parse(parts[0])
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: "invalid id"})
+			return
+		}
+		if r.Method != http.MethodDelete {
+			writeJSON(w, http.StatusMethodNotAllowed, domain.ErrorResponse{Error: "method not allowed"})
+			return
+		}
+		h.handlePurgeFAQ(w, r, id)
+	default:
+		writeJSON(w, http.StatusNotFound, domain.ErrorResponse{Error: "not found"})
+	}
+}
+
+// ListTrash returns deleted FAQs.
+//
+// @Summary      List trash
+// @Description  Get FAQs moved to the trash, most recently deleted first
+// @Tags         trash
+// @Produce      json
+// @Success      200  {object}  domain.FAQFullListResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/trash [get]
+func (h *Handler) handleListTrash(w http.ResponseWriter, r *http.Request) {
+	items, err := h.faqService.ListDeleted(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQFullList(w, items)
+}
+
+// RestoreFAQ takes a FAQ out of the trash.
+//
+// @Summary      Restore FAQ
+// @Description  Restore a deleted FAQ from the trash
+// @Tags         trash
+// @Produce      json
+// @Param        id   path      string  true  "FAQ ID"
+// @Success      200  {object}  domain.FAQResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/restore [post]
+func (h *Handler) handleRestoreFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	restored, err := h.faqService.Restore(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQ(w, http.StatusOK, restored)
+}
+
+// PurgeFAQ permanently removes a FAQ from the trash.
+//
+// @Summary      Purge FAQ
+// @Description  Permanently delete a FAQ that is in the trash. Its revision history is kept.
+// @Tags         trash
+// @Produce      json
+// @Param        id   path      string  true  "FAQ ID"
+// @Success      200  {object}  domain.MessageResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/trash/{id} [delete]
+func (h *Handler) handlePurgeFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	if err := h.faqService.Purge(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.MessageResponse{Message: "FAQ purged successfully"})
+}
+
+// EmptyTrash permanently removes all FAQs from the trash.
+//
+// @Summary      Empty trash
+// @Description  Permanently delete every FAQ in the trash
+// @Tags         trash
+// @Produce      json
+// @Success      200  {object}  domain.PurgeResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/trash [delete]
+func (h *Handler) handleEmptyTrash(w http.ResponseWriter, r *http.Request) {
+	purged, err := h.faqService.EmptyTrash(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.DataResponse[domain.PurgeResult]{Data: domain.PurgeResult{Purged: purged}})
+}
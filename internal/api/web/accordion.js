@@ -0,0 +1,63 @@
+(function (global) {
+  "use strict";
+
+  // faqAccordion adds keyboard navigation to the accordion under root, a
+  // document or a shadow root: arrow keys move between the questions, Home
+  // and End jump to the first and the last one. Enter and Space toggle a
+  // question natively. A link to #faq-<id> opens that question.
+  function faqAccordion(root) {
+    if (!root) {
+      return;
+    }
+    root.addEventListener("keydown", function (event) {
+      var summary = event.target.closest && event.target.closest("summary");
+      if (!summary) {
+        return;
+      }
+      var all = Array.prototype.slice.call(root.querySelectorAll(".faq-item > summary"));
+      var i = all.indexOf(summary);
+      if (i < 0) {
+        return;
+      }
+      var next;
+      switch (event.key) {
+        case "ArrowDown":
+          next = all[(i + 1) % all.length];
+          break;
+        case "ArrowUp":
+          next = all[(i - 1 + all.length) % all.length];
+          break;
+        case "Home":
+          next = all[0];
+          break;
+        case "End":
+          next = all[all.length - 1];
+          break;
+        default:
+          return;
+      }
+      event.preventDefault();
+      next.focus();
+    });
+
+    function openLinked() {
+      var id = decodeURIComponent(global.location.hash.slice(1));
+      if (id.indexOf("faq-") !== 0) {
+        return;
+      }
+      var items = root.querySelectorAll(".faq-item");
+      for (var i = 0; i < items.length; i++) {
+        if (items[i].id === id) {
+          items[i].open = true;
+          items[i].scrollIntoView();
+          items[i].querySelector("summary").focus();
+          return;
+        }
+      }
+    }
+    openLinked();
+    global.addEventListener("hashchange", openLinked);
+  }
+
+  global.faqAccordion = faqAccordion;
+})(window);
@@ -0,0 +1,48 @@
+(function () {
+  "use strict";
+
+  // The accordion is loaded from the server the script comes from and put
+  // into a shadow root right before the script tag, or into the element
+  // named by data-target. data-* attributes of the script are passed on as
+  // query parameters of /faq.
+  var script = document.currentScript;
+  if (!script || !script.src) {
+    return;
+  }
+  var params = ["locale", "category_id", "group", "accent", "color", "background", "border", "radius"];
+
+  var src = new URL(script.src, document.baseURI);
+  var url = new URL(src.pathname.replace(/\/embed\.js$/, ""), src);
+  url.searchParams.set("embed", "1");
+  params.forEach(function (name) {
+    var value = script.getAttribute("data-" + name.replace("_", "-"));
+    if (value) {
+      url.searchParams.set(name, value);
+    }
+  });
+
+  var host = script.getAttribute("data-target") && document.querySelector(script.getAttribute("data-target"));
+  if (!host) {
+    host = document.createElement("div");
+    script.parentNode.insertBefore(host, script);
+  }
+  host.classList.add("faq-embed");
+  var root = host.shadowRoot || host.attachShadow({ mode: "open" });
+
+  fetch(url.toString(), { headers: { Accept: "text/html" } })
+    .then(function (res) {
+      if (!res.ok) {
+        throw new Error("faq: " + res.status + " " + res.statusText);
+      }
+      return res.text();
+    })
+    .then(function (html) {
+      root.innerHTML = html;
+      faqAccordion(root);
+    })
+    .catch(function (err) {
+      if (window.console) {
+        console.error(err);
+      }
+    });
+})();
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// SaveDraft stores the next version of a FAQ.
+//
+// @Summary      Save draft
+// @Description  Store draft content next to the live content and move the FAQ to draft. Published content keeps being served until the draft is published.
+// @Tags         workflow
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                   true  "FAQ ID"
+// @Param        payload  body      domain.SaveDraftRequest  true  "Draft payload"
+// @Success      200      {object}  domain.FAQResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      404      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/draft [put]
+func (h *Handler) handleSaveDraft(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	var req domain.SaveDraftRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	saved, err := h.faqService.SaveDraft(r.Context(), id, domain.FAQDraft{Title: req.Title, Content: req.Content})
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQ(w, http.StatusOK, saved)
+}
+
+// ChangeStatus moves a FAQ through the workflow.
+//
+// @Summary      Change status
+// @Description  Move a FAQ to another workflow status. Allowed: draft→in_review|archived, in_review→draft|published|archived, published→draft|archived, archived→draft.
+// @Tags         workflow
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                      true  "FAQ ID"
+// @Param        payload  body      domain.ChangeStatusRequest  true  "Target status"
+// @Success      200      {object}  domain.FAQResponse
+// @Failure      400      {object}  domain.ErrorResponse
+// @Failure      401      {object}  domain.ErrorResponse
+// @Failure      403      {object}  domain.ErrorResponse
+// @Failure      404      {object}  domain.ErrorResponse
+// @Failure      500      {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/status [post]
+func (h *Handler) handleChangeStatus(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	var req domain.ChangeStatusRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	updated, err := h.faqService.ChangeStatus(r.Context(), id, domain.FAQStatus(req.Status))
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQ(w, http.StatusOK, updated)
+}
+
+// PublishFAQ publishes a reviewed FAQ.
+//
+// @Summary      Publish FAQ
+// @Description  Atomically replace the live content with the draft and mark the FAQ published. The FAQ must be in review.
+// @Tags         workflow
+// @Produce      json
+// @Param        id   path      string  true  "FAQ ID"
+// @Success      200  {object}  domain.FAQResponse
+// @Failure      400  {object}  domain.ErrorResponse
+// @Failure      401  {object}  domain.ErrorResponse
+// @Failure      403  {object}  domain.ErrorResponse
+// @Failure      404  {object}  domain.ErrorResponse
+// @Failure      500  {object}  domain.ErrorResponse
+// @Security     ApiKeyAuth
+// @Security     BearerAuth
+// @Router       /faqs/{id}/publish [post]
+func (h *Handler) handlePublishFAQ(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	published, err := h.faqService.Publish(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeFAQ(w, http.StatusOK, published)
+}
@@ -1,22 +1,67 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	pc "github.com/nightmaker00/accordion-go/pkg/db/postgres"
+	"github.com/nightmaker00/accordion-go/pkg/db/sqlite"
 )
 
 type Config struct {
 	Server struct {
-		Address  string
-		Port     string
-		Timeouts struct {
+		Address string
+		Port    string
+		// DebugAddress is the internal listener of /debug/vars, kept off
+		// the public one. Empty disables it.
+		DebugAddress string
+		Timeouts     struct {
 			ReadSeconds  int
 			WriteSeconds int
 			IdleSeconds  int
 		}
 	}
+	Storage struct {
+		Driver string
+		// AutoMigrate applies pending migrations on start.
+		AutoMigrate bool
+	}
+	Search struct {
+		Language string
+	}
+	Locale struct {
+		Default   string
+		Fallbacks []string
+	}
+	HTTP struct {
+		ListCacheControl string
+		// TrustProxy takes client addresses from X-Forwarded-For.
+		TrustProxy bool
+		// TenantHosts maps host names to the tenant served under them.
+		TenantHosts map[string]string
+	}
+	Cache struct {
+		TTLSeconds int
+		MaxEntries int
+	}
+	Auth struct {
+		// Enabled requires credentials for every request that is not a read.
+		Enabled     bool
+		JWKSFile    string
+		JWTIssuer   string
+		JWTAudience string
+		// JWTRoleClaim names the token claim holding the role.
+		JWTRoleClaim string
+		// JWTTenantClaim names the token claim holding the tenant.
+		JWTTenantClaim string
+	}
+	Trash struct {
+		RetentionDays        int
+		PurgeIntervalMinutes int
+	}
+	SQLite sqlite.Config
 	pc.Config
 }
 
@@ -26,10 +71,25 @@ func Load() (*Config, error) {
 	//default address
 	cfg.Server.Address = "0.0.0.0"
 	cfg.Server.Port = "8080"
+	cfg.Server.DebugAddress = "127.0.0.1:6060"
 	cfg.Server.Timeouts.ReadSeconds = 5
 	cfg.Server.Timeouts.WriteSeconds = 10
 	cfg.Server.Timeouts.IdleSeconds = 60
 
+	cfg.Storage.Driver = "postgres"
+	cfg.Search.Language = "simple"
+	cfg.Locale.Default = "en"
+	cfg.HTTP.ListCacheControl = "public, max-age=60"
+	cfg.Cache.TTLSeconds = 30
+	cfg.Cache.MaxEntries = 1000
+	cfg.Auth.Enabled = true
+	cfg.Auth.JWTRoleClaim = "role"
+	cfg.Auth.JWTTenantClaim = "tenant"
+	cfg.Trash.RetentionDays = 30
+	cfg.Trash.PurgeIntervalMinutes = 60
+
+	cfg.SQLite.Path = "faq.db"
+
 	cfg.Config.Host = "localhost"
 	cfg.Config.Port = "5432"
 	cfg.Config.User = "postgres"
@@ -45,6 +105,10 @@ func Load() (*Config, error) {
 	if port := os.Getenv("SERVER_PORT"); port != "" {
 		cfg.Server.Port = port
 	}
+	// an explicitly empty value disables the debug listener
+	if addr, ok := os.LookupEnv("DEBUG_ADDRESS"); ok {
+		cfg.Server.DebugAddress = addr
+	}
 	if seconds, ok := getEnvInt("SERVER_READ_TIMEOUT_SECONDS"); ok {
 		cfg.Server.Timeouts.ReadSeconds = seconds
 	}
@@ -55,6 +119,74 @@ func Load() (*Config, error) {
 		cfg.Server.Timeouts.IdleSeconds = seconds
 	}
 
+	if driver := os.Getenv("STORAGE_DRIVER"); driver != "" {
+		cfg.Storage.Driver = driver
+	}
+	if migrate, ok := getEnvBool("AUTO_MIGRATE"); ok {
+		cfg.Storage.AutoMigrate = migrate
+	}
+
+	if lang := os.Getenv("SEARCH_LANGUAGE"); lang != "" {
+		cfg.Search.Language = lang
+	}
+
+	if locale := os.Getenv("DEFAULT_LOCALE"); locale != "" {
+		cfg.Locale.Default = locale
+	}
+	if fallbacks := getEnvList("LOCALE_FALLBACKS"); len(fallbacks) > 0 {
+		cfg.Locale.Fallbacks = fallbacks
+	}
+
+	// an explicitly empty value disables the header
+	if value, ok := os.LookupEnv("LIST_CACHE_CONTROL"); ok {
+		cfg.HTTP.ListCacheControl = value
+	}
+	if trust, ok := getEnvBool("TRUST_PROXY_HEADERS"); ok {
+		cfg.HTTP.TrustProxy = trust
+	}
+	hosts, err := getEnvMap("TENANT_HOSTS")
+	if err != nil {
+		return nil, err
+	}
+	cfg.HTTP.TenantHosts = hosts
+
+	if seconds, ok := getEnvInt("CACHE_TTL_SECONDS"); ok {
+		cfg.Cache.TTLSeconds = seconds
+	}
+	if entries, ok := getEnvInt("CACHE_MAX_ENTRIES"); ok {
+		cfg.Cache.MaxEntries = entries
+	}
+
+	if enabled, ok := getEnvBool("AUTH_ENABLED"); ok {
+		cfg.Auth.Enabled = enabled
+	}
+	if path := os.Getenv("AUTH_JWKS_FILE"); path != "" {
+		cfg.Auth.JWKSFile = path
+	}
+	if issuer := os.Getenv("AUTH_JWT_ISSUER"); issuer != "" {
+		cfg.Auth.JWTIssuer = issuer
+	}
+	if audience := os.Getenv("AUTH_JWT_AUDIENCE"); audience != "" {
+		cfg.Auth.JWTAudience = audience
+	}
+	if claim := os.Getenv("AUTH_JWT_ROLE_CLAIM"); claim != "" {
+		cfg.Auth.JWTRoleClaim = claim
+	}
+	if claim := os.Getenv("AUTH_JWT_TENANT_CLAIM"); claim != "" {
+		cfg.Auth.JWTTenantClaim = claim
+	}
+
+	if days, ok := getEnvInt("TRASH_RETENTION_DAYS"); ok {
+		cfg.Trash.RetentionDays = days
+	}
+	if minutes, ok := getEnvInt("TRASH_PURGE_INTERVAL_MINUTES"); ok {
+		cfg.Trash.PurgeIntervalMinutes = minutes
+	}
+
+	if path := os.Getenv("SQLITE_PATH"); path != "" {
+		cfg.SQLite.Path = path
+	}
+
 	if host := os.Getenv("POSTGRES_HOST"); host != "" {
 		cfg.Config.Host = host
 	}
@@ -91,3 +223,43 @@ func getEnvInt(key string) (int, bool) {
 	}
 	return value, true
 }
+
+func getEnvBool(key string) (bool, bool) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return false, false
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, false
+	}
+	return value, true
+}
+
+func getEnvList(key string) []string {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return nil
+	}
+	out := make([]string, 0)
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// getEnvMap parses a comma separated list of key=value pairs.
+func getEnvMap(key string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, item := range getEnvList(key) {
+		k, v, ok := strings.Cut(item, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" || v == "" {
+			return nil, fmt.Errorf("%s: %q is not a key=value pair", key, item)
+		}
+		out[k] = v
+	}
+	return out, nil
+}
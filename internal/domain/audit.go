@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+// Restore takes a FAQ out of the trash or brings a purged one back from
+// its history; purge removes it from the trash for good.
+const (
+	AuditCreate  AuditAction = "create"
+	AuditUpdate  AuditAction = "update"
+	AuditDelete  AuditAction = "delete"
+	AuditRestore AuditAction = "restore"
+	AuditPurge   AuditAction = "purge"
+)
+
+func (a AuditAction) Valid() bool {
+	switch a {
+	case AuditCreate, AuditUpdate, AuditDelete, AuditRestore, AuditPurge:
+		return true
+	}
+	return false
+}
+
+// AuditEvent records who changed a FAQ and how. Before is nil for
+// creations and After is nil for deletions and purges. Events of a
+// translation carry its locale, and their snapshots the translated title
+// and content.
+type AuditEvent struct {
+	ID        uuid.UUID
+	Action    AuditAction
+	Actor     string
+	FAQID     uuid.UUID
+	Locale    string
+	Before    *FAQSnapshot
+	After     *FAQSnapshot
+	RequestID string
+	ClientIP  string
+	CreatedAt time.Time
+}
+
+type CreateAuditEventInput struct {
+	Action    AuditAction
+	Actor     string
+	FAQID     uuid.UUID
+	Locale    string
+	Before    *FAQSnapshot
+	After     *FAQSnapshot
+	RequestID string
+	ClientIP  string
+}
+
+// NewAuditEventInput describes a change of a FAQ made by the request in
+// ctx. before is nil for creations and after is nil for deletions.
+func NewAuditEventInput(ctx context.Context, action AuditAction, before, after *FAQ) CreateAuditEventInput {
+	in := CreateAuditEventInput{
+		Action:    action,
+		Actor:     ActorFromContext(ctx),
+		RequestID: RequestIDFromContext(ctx),
+		ClientIP:  ClientIPFromContext(ctx),
+	}
+	if before != nil {
+		snap := NewFAQSnapshot(*before)
+		in.Before, in.FAQID = &snap, before.ID
+	}
+	if after != nil {
+		snap := NewFAQSnapshot(*after)
+		in.After, in.FAQID = &snap, after.ID
+	}
+	return in
+}
+
+// NewTranslationAuditEventInput describes a change of a translation of
+// faq. before is nil when the translation is added and after is nil when
+// it is removed.
+func NewTranslationAuditEventInput(ctx context.Context, action AuditAction, faq FAQ, locale string, before, after *Translation) CreateAuditEventInput {
+	in := NewAuditEventInput(ctx, action, nil, nil)
+	in.FAQID, in.Locale = faq.ID, locale
+	if before != nil {
+		snap := NewFAQSnapshot(faq)
+		snap.Title, snap.Content = before.Title, before.Content
+		in.Before = &snap
+	}
+	if after != nil {
+		snap := NewFAQSnapshot(faq)
+		snap.Title, snap.Content = after.Title, after.Content
+		in.After = &snap
+	}
+	return in
+}
+
+// AuditFilter selects audit events, newest first. Zero values disable a
+// filter. The time range includes From and excludes To. After continues
+// the list past a cursor.
+type AuditFilter struct {
+	Actor string
+	FAQID *uuid.UUID
+	From  *time.Time
+	To    *time.Time
+	Limit int
+	After *AuditCursor
+}
+
+// AuditPage is one page of the audit log. NextCursor is empty on the last
+// page.
+type AuditPage struct {
+	Items      []AuditEvent
+	NextCursor string
+}
+
+// AuditCursor points right after an event in the audit log.
+type AuditCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func NewAuditCursor(e AuditEvent) AuditCursor {
+	return AuditCursor{CreatedAt: e.CreatedAt, ID: e.ID}
+}
+
+// Encode returns the opaque form of the cursor passed to clients.
+func (c AuditCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeAuditCursor(s string) (AuditCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return AuditCursor{}, errInvalidCursor
+	}
+	var c AuditCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return AuditCursor{}, errInvalidCursor
+	}
+	return c, nil
+}
+
+// @Description AuditEventFullResponse is an entry of the audit log.
+type AuditEventFullResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	Action    string       `json:"action"`
+	Actor     string       `json:"actor"`
+	FAQID     uuid.UUID    `json:"faq_id"`
+	Locale    string       `json:"locale,omitempty"`
+	Before    *FAQSnapshot `json:"before"`
+	After     *FAQSnapshot `json:"after"`
+	RequestID string       `json:"request_id"`
+	ClientIP  string       `json:"client_ip"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// @Description AuditPageResponse wraps a page of the audit log.
+type AuditPageResponse struct {
+	Data       []AuditEventFullResponse `json:"data"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
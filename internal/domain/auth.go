@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnauthorized reports missing, unknown or invalid credentials.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden reports a caller whose role does not allow the operation.
+var ErrForbidden = errors.New("forbidden")
+
+const (
+	AuthMethodAPIKey = "api_key"
+	AuthMethodJWT    = "jwt"
+	// AuthMethodNone marks callers let in while authentication is disabled.
+	AuthMethodNone = "none"
+)
+
+// Role grants a set of permissions. Every role includes the permissions
+// of the roles before it: viewers read the admin views, editors create
+// and edit drafts, publishers change what is live and admins delete.
+type Role string
+
+const (
+	RoleViewer    Role = "viewer"
+	RoleEditor    Role = "editor"
+	RolePublisher Role = "publisher"
+	RoleAdmin     Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer:    1,
+	RoleEditor:    2,
+	RolePublisher: 3,
+	RoleAdmin:     4,
+}
+
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Includes reports whether r grants everything other grants.
+func (r Role) Includes(other Role) bool {
+	return r.Valid() && roleRanks[r] >= roleRanks[other]
+}
+
+// Principal is the caller of a request. Subject is the name of the API
+// key or the sub claim of the token. Tenant is the only tenant the caller
+// may work with; it is empty only for principals made by the server
+// itself, which may work with every tenant.
+type Principal struct {
+	Subject string
+	Method  string
+	Role    Role
+	Tenant  string
+}
+
+type principalKey struct{}
+
+// WithPrincipal stores the authenticated caller of the request.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal stored by WithPrincipal.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// APIKey is a stored API key of a tenant. Only the hash of the key is
+// kept.
+type APIKey struct {
+	ID        uuid.UUID
+	Name      string
+	Role      Role
+	Tenant    string
+	Hash      string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+type CreateAPIKeyInput struct {
+	Name string
+	Role Role
+	Hash string
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrBatchRolledBack marks the operations of an atomic batch that were
+// undone because another operation of the batch failed.
+var ErrBatchRolledBack = errors.New("batch rolled back")
+
+// BatchAction is the kind of change a batch operation makes.
+type BatchAction string
+
+const (
+	BatchCreate BatchAction = "create"
+	BatchUpdate BatchAction = "update"
+	BatchDelete BatchAction = "delete"
+)
+
+func (a BatchAction) Valid() bool {
+	switch a {
+	case BatchCreate, BatchUpdate, BatchDelete:
+		return true
+	}
+	return false
+}
+
+// BatchOperation is one change of a batch. ID addresses updates and
+// deletes; Create and Update hold the input of the respective action.
+type BatchOperation struct {
+	Action BatchAction
+	ID     uuid.UUID
+	Create CreateFAQInput
+	Update UpdateFAQInput
+}
+
+// BatchResult is the outcome of one operation of a batch. FAQ is the
+// created or updated FAQ and nil for deletes and failures.
+type BatchResult struct {
+	FAQ *FAQ
+	Err error
+}
+
+// BatchError tells which operation made an atomic batch fail.
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e BatchError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
+
+// @Description BatchOperationRequest is one operation of a batch. create takes the fields of CreateFAQRequest; update takes id, the fields of UpdateFAQRequest and optionally the version being edited; delete takes only id.
+type BatchOperationRequest struct {
+	Op            string     `json:"op" enums:"create,update,delete"`
+	ID            *uuid.UUID `json:"id"`
+	Version       int        `json:"version"`
+	CategoryID    *uuid.UUID `json:"category_id"`
+	Title         string     `json:"title"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format" enums:"plain,markdown,html"`
+	Position      int        `json:"position"`
+	IsActive      *bool      `json:"is_active"`
+	Status        *string    `json:"status" enums:"draft,in_review,published"`
+	PublishAt     *time.Time `json:"publish_at"`
+	ExpireAt      *time.Time `json:"expire_at"`
+}
+
+// @Description BatchRequest describes request body for a batch of FAQ changes.
+type BatchRequest struct {
+	Atomic     bool                    `json:"atomic"`
+	Operations []BatchOperationRequest `json:"operations"`
+}
+
+// @Description BatchOperationResponse is the outcome of one operation: the HTTP status it would have had on its own and either the FAQ or the error.
+type BatchOperationResponse struct {
+	Status int              `json:"status"`
+	Data   *FAQFullResponse `json:"data,omitempty"`
+	Error  *ErrorResponse   `json:"error,omitempty"`
+}
+
+// @Description BatchResponse lists the outcome of every operation in request order.
+type BatchResponse struct {
+	Data []BatchOperationResponse `json:"data"`
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// @Description Category is an internal model for a FAQ section.
+type Category struct {
+	ID        uuid.UUID
+	ParentID  *uuid.UUID
+	Name      string
+	Slug      string
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FAQGroup is a category together with its active FAQs and nested sections.
+// Category is nil for the group of FAQs without a category.
+type FAQGroup struct {
+	Category *Category
+	Items    []FAQ
+	Children []FAQGroup
+}
+
+// @Description CreateCategoryRequest describes request body for creating a category.
+type CreateCategoryRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+	Position int        `json:"position"`
+}
+
+// @Description UpdateCategoryRequest describes request body for updating a category.
+type UpdateCategoryRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+	Position int        `json:"position"`
+}
+
+type CreateCategoryInput struct {
+	ParentID *uuid.UUID
+	Name     string
+	Slug     string
+	Position int
+}
+
+type UpdateCategoryInput struct {
+	ParentID *uuid.UUID
+	Name     string
+	Slug     string
+	Position int
+}
+
+// @Description CategoryFullResponse is a full category representation.
+type CategoryFullResponse struct {
+	ID       uuid.UUID  `json:"id"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+	Position int        `json:"position"`
+}
+
+// @Description CategoryListResponse wraps a category list response.
+type CategoryListResponse struct {
+	Data []CategoryFullResponse `json:"data"`
+}
+
+// @Description CategoryResponse wraps a single category response.
+type CategoryResponse struct {
+	Data CategoryFullResponse `json:"data"`
+}
+
+// @Description FAQGroupResponse is a category section of the accordion.
+type FAQGroupResponse struct {
+	Category *CategoryFullResponse `json:"category"`
+	Items    []FAQListItemResponse `json:"items"`
+	Children []FAQGroupResponse    `json:"children,omitempty"`
+}
+
+// @Description FAQGroupListResponse wraps a list of FAQs grouped by category.
+type FAQGroupListResponse struct {
+	Data []FAQGroupResponse `json:"data"`
+}
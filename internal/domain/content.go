@@ -0,0 +1,20 @@
+package domain
+
+// ContentFormat tells how the content of a FAQ is written; its
+// translations and draft share the format. Clients get the content
+// rendered to sanitized HTML alongside the raw text.
+type ContentFormat string
+
+const (
+	ContentPlain    ContentFormat = "plain"
+	ContentMarkdown ContentFormat = "markdown"
+	ContentHTML     ContentFormat = "html"
+)
+
+func (f ContentFormat) Valid() bool {
+	switch f {
+	case ContentPlain, ContentMarkdown, ContentHTML:
+		return true
+	}
+	return false
+}
@@ -2,10 +2,15 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 )
 
 var ErrNotFound = errors.New("not found")
 
+// ErrConflict reports a write that collided with a concurrent one. Retrying
+// it may succeed.
+var ErrConflict = errors.New("conflict")
+
 type ValidationError struct {
 	Message string
 }
@@ -13,3 +18,12 @@ type ValidationError struct {
 func (e ValidationError) Error() string {
 	return e.Message
 }
+
+// VersionConflictError reports a write based on a stale version of a FAQ.
+type VersionConflictError struct {
+	Current int
+}
+
+func (e VersionConflictError) Error() string {
+	return fmt.Sprintf("faq has been modified, current version is %d", e.Current)
+}
@@ -0,0 +1,22 @@
+package domain
+
+// @Description FAQPageDocument is a schema.org FAQPage in JSON-LD, ready to be put into a <script type="application/ld+json"> tag.
+type FAQPageDocument struct {
+	Context    string            `json:"@context" example:"https://schema.org"`
+	Type       string            `json:"@type" example:"FAQPage"`
+	MainEntity []FAQPageQuestion `json:"mainEntity"`
+}
+
+// @Description FAQPageQuestion is a schema.org Question with its accepted answer.
+type FAQPageQuestion struct {
+	Type           string        `json:"@type" example:"Question"`
+	Name           string        `json:"name"`
+	InLanguage     string        `json:"inLanguage,omitempty"`
+	AcceptedAnswer FAQPageAnswer `json:"acceptedAnswer"`
+}
+
+// @Description FAQPageAnswer is a schema.org Answer. text keeps only the HTML search engines accept in answers.
+type FAQPageAnswer struct {
+	Type string `json:"@type" example:"Answer"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FAQSortKey is a field the admin FAQ list can be sorted by.
+type FAQSortKey string
+
+const (
+	SortByPosition  FAQSortKey = "position"
+	SortByCreatedAt FAQSortKey = "created_at"
+	SortByUpdatedAt FAQSortKey = "updated_at"
+	SortByTitle     FAQSortKey = "title"
+)
+
+func (k FAQSortKey) Valid() bool {
+	switch k {
+	case SortByPosition, SortByCreatedAt, SortByUpdatedAt, SortByTitle:
+		return true
+	}
+	return false
+}
+
+// FAQListFilter selects FAQs for the admin list, including inactive and
+// unpublished ones. Zero values disable a filter. Created and updated
+// ranges include From and exclude To. Query matches title or content as a
+// case-insensitive substring. After continues the list past a cursor.
+type FAQListFilter struct {
+	IsActive    *bool
+	Status      FAQStatus
+	CategoryID  *uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	Query       string
+	Sort        FAQSortKey
+	Desc        bool
+	Limit       int
+	After       *FAQCursor
+}
+
+// FAQPage is one page of the admin list. NextCursor is empty on the last
+// page.
+type FAQPage struct {
+	Items      []FAQ
+	NextCursor string
+}
+
+// FAQCursor points right after a FAQ in a list sorted by Sort. Value is
+// the sort field of that FAQ; ID breaks ties.
+type FAQCursor struct {
+	Sort  FAQSortKey `json:"s"`
+	Desc  bool       `json:"d,omitempty"`
+	Value string     `json:"v"`
+	ID    uuid.UUID  `json:"id"`
+}
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// NewFAQCursor returns the cursor following faq in a list sorted by sort.
+func NewFAQCursor(sort FAQSortKey, desc bool, faq FAQ) FAQCursor {
+	c := FAQCursor{Sort: sort, Desc: desc, ID: faq.ID}
+	switch sort {
+	case SortByPosition:
+		c.Value = strconv.Itoa(faq.Position)
+	case SortByCreatedAt:
+		c.Value = faq.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case SortByUpdatedAt:
+		c.Value = faq.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case SortByTitle:
+		c.Value = faq.Title
+	}
+	return c
+}
+
+// Encode returns the opaque form of the cursor passed to clients.
+func (c FAQCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeFAQCursor(s string) (FAQCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return FAQCursor{}, errInvalidCursor
+	}
+	var c FAQCursor
+	if err := json.Unmarshal(raw, &c); err != nil || !c.Sort.Valid() || c.ID == uuid.Nil {
+		return FAQCursor{}, errInvalidCursor
+	}
+	switch c.Sort {
+	case SortByPosition:
+		_, err = strconv.Atoi(c.Value)
+	case SortByCreatedAt, SortByUpdatedAt:
+		_, err = time.Parse(time.RFC3339Nano, c.Value)
+	}
+	if err != nil {
+		return FAQCursor{}, errInvalidCursor
+	}
+	return c, nil
+}
+
+// @Description FAQPageResponse wraps a page of the admin FAQ list.
+type FAQPageResponse struct {
+	Data       []FAQFullResponse `json:"data"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// NormalizeLocale lowercases a BCP 47 language tag and replaces
+// underscores, so "en_US" and "en-us" are the same locale.
+func NormalizeLocale(raw string) (string, bool) {
+	locale := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(raw), "_", "-"))
+	if !localePattern.MatchString(locale) {
+		return "", false
+	}
+	return locale, true
+}
+
+// BaseLocale returns the primary language subtag: "en" for "en-us".
+func BaseLocale(locale string) string {
+	if i := strings.IndexByte(locale, '-'); i > 0 {
+		return locale[:i]
+	}
+	return locale
+}
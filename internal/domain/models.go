@@ -8,65 +8,142 @@ import (
 
 // @Description FAQ is an internal model used by service and repository.
 type FAQ struct {
-	ID        uuid.UUID
-	Title     string
-	Content   string
-	Position  int
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            uuid.UUID
+	CategoryID    *uuid.UUID
+	Locale        string
+	Title         string
+	Content       string
+	ContentFormat ContentFormat
+	Position      int
+	IsActive      bool
+	Status        FAQStatus
+	Draft         *FAQDraft
+	PublishedAt   *time.Time
+	PublishAt     *time.Time
+	ExpireAt      *time.Time
+	DeletedAt     *time.Time
+	Version       int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Scheduled reports whether now falls into the publish/expire window.
+func (f FAQ) Scheduled(now time.Time) bool {
+	if f.PublishAt != nil && now.Before(*f.PublishAt) {
+		return false
+	}
+	if f.ExpireAt != nil && !now.Before(*f.ExpireAt) {
+		return false
+	}
+	return true
+}
+
+// ListActiveFilter narrows down the public FAQ list. Locales lists the
+// preferred content locales, most preferred first. At is the moment the
+// publish/expire windows are checked against.
+type ListActiveFilter struct {
+	CategoryID *uuid.UUID
+	Locales    []string
+	At         time.Time
+}
+
+// ActiveFAQs is the public FAQ list together with the moment the public
+// FAQs of the tenant last changed. Unlike the update times of the listed
+// FAQs, LastModified also moves when a FAQ leaves the list, so it can back
+// a Last-Modified header. It is zero while nothing has been written.
+type ActiveFAQs struct {
+	Items        []FAQ
+	LastModified time.Time
 }
 
 // @Description CreateFAQRequest describes request body for creating a FAQ.
 type CreateFAQRequest struct {
-	Title    string `json:"title"`
-	Content  string `json:"content"`
-	Position int    `json:"position"`
-	IsActive *bool  `json:"is_active"`
+	CategoryID    *uuid.UUID `json:"category_id"`
+	Title         string     `json:"title"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format" enums:"plain,markdown,html"`
+	Position      int        `json:"position"`
+	IsActive      *bool      `json:"is_active"`
+	Status        *string    `json:"status" enums:"draft,in_review,published"`
+	PublishAt     *time.Time `json:"publish_at"`
+	ExpireAt      *time.Time `json:"expire_at"`
 }
 
-// @Description UpdateFAQRequest describes request body for updating a FAQ.
+// @Description UpdateFAQRequest describes request body for updating a FAQ. An omitted content_format keeps the current one.
 type UpdateFAQRequest struct {
-	Title    string `json:"title"`
-	Content  string `json:"content"`
-	Position int    `json:"position"`
-	IsActive *bool  `json:"is_active"`
+	CategoryID    *uuid.UUID `json:"category_id"`
+	Title         string     `json:"title"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format" enums:"plain,markdown,html"`
+	Position      int        `json:"position"`
+	IsActive      *bool      `json:"is_active"`
+	PublishAt     *time.Time `json:"publish_at"`
+	ExpireAt      *time.Time `json:"expire_at"`
 }
 
 type CreateFAQInput struct {
-	Title    string
-	Content  string
-	Position int
-	IsActive bool
+	CategoryID    *uuid.UUID
+	Title         string
+	Content       string
+	ContentFormat ContentFormat
+	Position      int
+	IsActive      bool
+	Status        FAQStatus
+	PublishAt     *time.Time
+	ExpireAt      *time.Time
 }
 
+// UpdateFAQInput replaces the editable fields of a FAQ. A non-zero Version
+// must match the current version of the FAQ. An empty ContentFormat keeps
+// the current one.
 type UpdateFAQInput struct {
-	Title    string
-	Content  string
-	Position int
-	IsActive bool
+	CategoryID    *uuid.UUID
+	Title         string
+	Content       string
+	ContentFormat ContentFormat
+	Position      int
+	IsActive      bool
+	PublishAt     *time.Time
+	ExpireAt      *time.Time
+	Version       int
 }
 
-// @Description FAQListItemResponse is a short FAQ representation used in lists.
+// @Description FAQListItemResponse is a short FAQ representation used in lists. content_html is the content rendered to sanitized HTML.
 type FAQListItemResponse struct {
-	ID       uuid.UUID `json:"id"`
-	Title    string    `json:"title"`
-	Content  string    `json:"content"`
-	Position int       `json:"position"`
+	ID            uuid.UUID  `json:"id"`
+	CategoryID    *uuid.UUID `json:"category_id,omitempty"`
+	Locale        string     `json:"locale"`
+	Title         string     `json:"title"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format"`
+	ContentHTML   string     `json:"content_html"`
+	Position      int        `json:"position"`
 }
 
-// @Description FAQFullResponse is a full FAQ representation.
+// @Description FAQFullResponse is a full FAQ representation. content_html is the content rendered to sanitized HTML.
 type FAQFullResponse struct {
-	ID       uuid.UUID `json:"id"`
-	Title    string    `json:"title"`
-	Content  string    `json:"content"`
-	Position int       `json:"position"`
-	IsActive bool      `json:"is_active"`
+	ID            uuid.UUID  `json:"id"`
+	CategoryID    *uuid.UUID `json:"category_id,omitempty"`
+	Locale        string     `json:"locale"`
+	Title         string     `json:"title"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format"`
+	ContentHTML   string     `json:"content_html"`
+	Position      int        `json:"position"`
+	IsActive      bool       `json:"is_active"`
+	Status        string     `json:"status"`
+	Draft         *FAQDraft  `json:"draft,omitempty"`
+	PublishedAt   *time.Time `json:"published_at,omitempty"`
+	PublishAt     *time.Time `json:"publish_at,omitempty"`
+	ExpireAt      *time.Time `json:"expire_at,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	Version       int        `json:"version"`
 }
 
 // @Description DataResponse wraps API response payloads.
 type DataResponse[T any] struct {
-	Data T `json:"data"`
+	Data       T      `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // @Description FAQListResponse wraps a list response.
@@ -74,6 +151,11 @@ type FAQListResponse struct {
 	Data []FAQListItemResponse `json:"data"`
 }
 
+// @Description FAQFullListResponse wraps a list of full FAQs.
+type FAQFullListResponse struct {
+	Data []FAQFullResponse `json:"data"`
+}
+
 // @Description FAQResponse wraps a single FAQ response.
 type FAQResponse struct {
 	Data FAQFullResponse `json:"data"`
@@ -0,0 +1,30 @@
+package domain
+
+import "github.com/google/uuid"
+
+// ReorderInput lists every FAQ of a category in the new order. A nil
+// CategoryID addresses FAQs without a category.
+type ReorderInput struct {
+	CategoryID *uuid.UUID
+	IDs        []uuid.UUID
+}
+
+// MoveInput places a FAQ right before or after another FAQ of the same
+// category.
+type MoveInput struct {
+	ID     uuid.UUID
+	Target uuid.UUID
+	After  bool
+}
+
+// @Description ReorderFAQsRequest describes request body for reordering the FAQs of a category.
+type ReorderFAQsRequest struct {
+	CategoryID *uuid.UUID  `json:"category_id"`
+	IDs        []uuid.UUID `json:"ids"`
+}
+
+// @Description MoveFAQRequest describes request body for moving a FAQ next to another one. Exactly one of before and after is required.
+type MoveFAQRequest struct {
+	Before *uuid.UUID `json:"before"`
+	After  *uuid.UUID `json:"after"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PatchField is one field of a partial update. Fields that are not Set
+// keep their current value; nullable fields are cleared by setting a nil
+// Value.
+type PatchField[T any] struct {
+	Set   bool
+	Value T
+}
+
+// FAQPatch is a partial update of a FAQ. A non-zero Version must match
+// the current version of the FAQ.
+type FAQPatch struct {
+	CategoryID    PatchField[*uuid.UUID]
+	Title         PatchField[string]
+	Content       PatchField[string]
+	ContentFormat PatchField[ContentFormat]
+	Position      PatchField[int]
+	IsActive      PatchField[bool]
+	PublishAt     PatchField[*time.Time]
+	ExpireAt      PatchField[*time.Time]
+	Version       int
+}
+
+// Empty reports whether the patch leaves every field unchanged.
+func (p FAQPatch) Empty() bool {
+	return !p.CategoryID.Set && !p.Title.Set && !p.Content.Set && !p.ContentFormat.Set && !p.Position.Set &&
+		!p.IsActive.Set && !p.PublishAt.Set && !p.ExpireAt.Set
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RevisionAction string
+
+const (
+	RevisionCreate  RevisionAction = "create"
+	RevisionUpdate  RevisionAction = "update"
+	RevisionDelete  RevisionAction = "delete"
+	RevisionRestore RevisionAction = "restore"
+)
+
+// @Description FAQSnapshot is the full state of a FAQ at some revision.
+type FAQSnapshot struct {
+	ID            uuid.UUID     `json:"id"`
+	CategoryID    *uuid.UUID    `json:"category_id"`
+	Title         string        `json:"title"`
+	Content       string        `json:"content"`
+	ContentFormat ContentFormat `json:"content_format"`
+	Position      int           `json:"position"`
+	IsActive      bool          `json:"is_active"`
+	Status        FAQStatus     `json:"status"`
+	Draft         *FAQDraft     `json:"draft"`
+	PublishAt     *time.Time    `json:"publish_at"`
+	ExpireAt      *time.Time    `json:"expire_at"`
+	Version       int           `json:"version"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+func NewFAQSnapshot(f FAQ) FAQSnapshot {
+	return FAQSnapshot{
+		ID:            f.ID,
+		CategoryID:    f.CategoryID,
+		Title:         f.Title,
+		Content:       f.Content,
+		ContentFormat: f.ContentFormat,
+		Position:      f.Position,
+		IsActive:      f.IsActive,
+		Status:        f.Status,
+		Draft:         f.Draft,
+		PublishAt:     f.PublishAt,
+		ExpireAt:      f.ExpireAt,
+		Version:       f.Version,
+		CreatedAt:     f.CreatedAt,
+		UpdatedAt:     f.UpdatedAt,
+	}
+}
+
+// Revision is an immutable record written on every FAQ mutation.
+// For deletions Snapshot holds the state right before the delete.
+type Revision struct {
+	FAQID     uuid.UUID
+	Number    int
+	Action    RevisionAction
+	Actor     string
+	Snapshot  FAQSnapshot
+	CreatedAt time.Time
+}
+
+type RevisionChange struct {
+	Field string
+	From  any
+	To    any
+}
+
+type RevisionDiff struct {
+	FAQID   uuid.UUID
+	From    int
+	To      int
+	Changes []RevisionChange
+}
+
+// @Description RevisionFullResponse is a FAQ revision.
+type RevisionFullResponse struct {
+	FAQID     uuid.UUID   `json:"faq_id"`
+	Revision  int         `json:"revision"`
+	Action    string      `json:"action"`
+	Actor     string      `json:"actor"`
+	CreatedAt time.Time   `json:"created_at"`
+	Snapshot  FAQSnapshot `json:"snapshot"`
+}
+
+// @Description RevisionListResponse wraps a revision list response.
+type RevisionListResponse struct {
+	Data []RevisionFullResponse `json:"data"`
+}
+
+// @Description RevisionResponse wraps a single revision response.
+type RevisionResponse struct {
+	Data RevisionFullResponse `json:"data"`
+}
+
+// @Description RevisionChangeResponse is a changed field between two revisions.
+type RevisionChangeResponse struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// @Description RevisionDiffFullResponse lists fields changed between two revisions.
+type RevisionDiffFullResponse struct {
+	FAQID   uuid.UUID                `json:"faq_id"`
+	From    int                      `json:"from"`
+	To      int                      `json:"to"`
+	Changes []RevisionChangeResponse `json:"changes"`
+}
+
+// @Description RevisionDiffResponse wraps a revision diff response.
+type RevisionDiffResponse struct {
+	Data RevisionDiffFullResponse `json:"data"`
+}
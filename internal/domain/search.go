@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchQuery describes a full-text search over active FAQs.
+// Language is a text search configuration name (e.g. "english"),
+// At is the moment publish/expire windows are checked against.
+type SearchQuery struct {
+	Query      string
+	Language   string
+	CategoryID *uuid.UUID
+	Limit      int
+	At         time.Time
+}
+
+// SearchResult is a matched FAQ with its rank and highlighted snippets.
+// Highlights are HTML-escaped with matches wrapped into <mark> tags.
+type SearchResult struct {
+	FAQ              FAQ
+	Rank             float64
+	TitleHighlight   string
+	ContentHighlight string
+}
+
+// @Description FAQSearchItemResponse is a ranked search hit.
+type FAQSearchItemResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	CategoryID       *uuid.UUID `json:"category_id,omitempty"`
+	Title            string     `json:"title"`
+	Content          string     `json:"content"`
+	ContentFormat    string     `json:"content_format"`
+	ContentHTML      string     `json:"content_html"`
+	Position         int        `json:"position"`
+	Rank             float64    `json:"rank"`
+	TitleHighlight   string     `json:"title_highlight"`
+	ContentHighlight string     `json:"content_highlight"`
+}
+
+// @Description FAQSearchResponse wraps search results.
+type FAQSearchResponse struct {
+	Data []FAQSearchItemResponse `json:"data"`
+}
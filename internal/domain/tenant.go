@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+)
+
+// DefaultTenant owns requests that name no tenant and everything stored
+// before tenants existed.
+const DefaultTenant = "default"
+
+// AnyTenant lets PurgeDeleted empty the trash of every tenant. It is not
+// a valid tenant, so every other query matches nothing with it.
+const AnyTenant = "*"
+
+var tenantPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// ValidTenant reports whether s can name a tenant: lowercase letters,
+// digits and dashes, up to 63 characters, not starting with a dash.
+func ValidTenant(s string) bool {
+	return tenantPattern.MatchString(s)
+}
+
+type tenantKey struct{}
+
+// WithTenant stores the tenant whose data the request works with.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant stored by WithTenant or
+// DefaultTenant.
+func TenantFromContext(ctx context.Context) string {
+	if tenant, ok := ctx.Value(tenantKey{}).(string); ok && tenant != "" {
+		return tenant
+	}
+	return DefaultTenant
+}
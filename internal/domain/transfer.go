@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransferFormat is a file format FAQs are exported to and imported from.
+type TransferFormat string
+
+const (
+	FormatJSON     TransferFormat = "json"
+	FormatCSV      TransferFormat = "csv"
+	FormatYAML     TransferFormat = "yaml"
+	FormatMarkdown TransferFormat = "md"
+)
+
+func (f TransferFormat) Valid() bool {
+	switch f {
+	case FormatJSON, FormatCSV, FormatYAML, FormatMarkdown:
+		return true
+	}
+	return false
+}
+
+// ImportStrategy decides what an import does with the FAQs already stored.
+// Upsert updates the FAQs whose ID is in the file and keeps the others;
+// replace also moves every FAQ missing from the file to the trash.
+type ImportStrategy string
+
+const (
+	ImportUpsert  ImportStrategy = "upsert"
+	ImportReplace ImportStrategy = "replace"
+)
+
+func (s ImportStrategy) Valid() bool {
+	return s == ImportUpsert || s == ImportReplace
+}
+
+// ImportItem is one FAQ of an import. Row is its 1-based number in the
+// file. Items without an ID are created. An empty ContentFormat keeps the
+// current one, or is plain for new FAQs.
+type ImportItem struct {
+	Row           int
+	ID            *uuid.UUID
+	CategoryID    *uuid.UUID
+	Title         string
+	Content       string
+	ContentFormat ContentFormat
+	Position      int
+	IsActive      bool
+	Status        FAQStatus
+	PublishAt     *time.Time
+	ExpireAt      *time.Time
+}
+
+// Unchanged reports whether applying the item to f would leave it as it
+// is, so that no new version is written.
+func (it ImportItem) Unchanged(f FAQ) bool {
+	return sameUUID(it.CategoryID, f.CategoryID) && it.Title == f.Title && it.Content == f.Content &&
+		(it.ContentFormat == "" || it.ContentFormat == f.ContentFormat) && it.Position == f.Position &&
+		it.IsActive == f.IsActive && it.Status == f.Status &&
+		sameTime(it.PublishAt, f.PublishAt) && sameTime(it.ExpireAt, f.ExpireAt)
+}
+
+// ImportInput is a whole import. DryRun checks every row and reports what
+// would change without storing anything.
+type ImportInput struct {
+	Items    []ImportItem
+	Strategy ImportStrategy
+	DryRun   bool
+}
+
+// ImportError explains why a row of an import was rejected.
+type ImportError struct {
+	Row     int
+	Message string
+}
+
+// ImportChange is a FAQ an import created, updated or moved to the trash.
+// Row is 0 for FAQs trashed because they are missing from the file.
+type ImportChange struct {
+	Row    int
+	Action AuditAction
+	Before *FAQ
+	After  *FAQ
+}
+
+// ImportResult lists what an import changed. Nothing is stored when
+// Errors is not empty.
+type ImportResult struct {
+	Changes   []ImportChange
+	Unchanged int
+	Errors    []ImportError
+}
+
+// Count returns the number of changes with the action.
+func (r ImportResult) Count(action AuditAction) int {
+	n := 0
+	for _, c := range r.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// @Description FAQRecord is a FAQ in an export file. Import reads the same fields; published_at, version and the timestamps are informational there and are ignored.
+type FAQRecord struct {
+	ID            *uuid.UUID    `json:"id,omitempty" yaml:"id,omitempty"`
+	CategoryID    *uuid.UUID    `json:"category_id" yaml:"category_id"`
+	Title         string        `json:"title" yaml:"title"`
+	Content       string        `json:"content" yaml:"content"`
+	ContentFormat ContentFormat `json:"content_format,omitempty" yaml:"content_format,omitempty" enums:"plain,markdown,html"`
+	Position      int           `json:"position" yaml:"position"`
+	IsActive      *bool         `json:"is_active,omitempty" yaml:"is_active,omitempty"`
+	Status        FAQStatus     `json:"status,omitempty" yaml:"status,omitempty" enums:"draft,in_review,published,archived"`
+	PublishAt     *time.Time    `json:"publish_at" yaml:"publish_at"`
+	ExpireAt      *time.Time    `json:"expire_at" yaml:"expire_at"`
+	PublishedAt   *time.Time    `json:"published_at,omitempty" yaml:"published_at,omitempty"`
+	Version       int           `json:"version,omitempty" yaml:"version,omitempty"`
+	CreatedAt     *time.Time    `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt     *time.Time    `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// NewFAQRecord exports a FAQ.
+func NewFAQRecord(f FAQ) FAQRecord {
+	id, isActive := f.ID, f.IsActive
+	created, updated := f.CreatedAt, f.UpdatedAt
+	return FAQRecord{
+		ID:            &id,
+		CategoryID:    f.CategoryID,
+		Title:         f.Title,
+		Content:       f.Content,
+		ContentFormat: f.ContentFormat,
+		Position:      f.Position,
+		IsActive:      &isActive,
+		Status:        f.Status,
+		PublishAt:     f.PublishAt,
+		ExpireAt:      f.ExpireAt,
+		PublishedAt:   f.PublishedAt,
+		Version:       f.Version,
+		CreatedAt:     &created,
+		UpdatedAt:     &updated,
+	}
+}
+
+// ImportItem converts a record read from the row-th entry of a file. A
+// record without is_active is imported as active.
+func (r FAQRecord) ImportItem(row int) ImportItem {
+	isActive := true
+	if r.IsActive != nil {
+		isActive = *r.IsActive
+	}
+	return ImportItem{
+		Row:           row,
+		ID:            r.ID,
+		CategoryID:    r.CategoryID,
+		Title:         r.Title,
+		Content:       r.Content,
+		ContentFormat: r.ContentFormat,
+		Position:      r.Position,
+		IsActive:      isActive,
+		Status:        r.Status,
+		PublishAt:     r.PublishAt,
+		ExpireAt:      r.ExpireAt,
+	}
+}
+
+// @Description FAQDump is the document exported as JSON or YAML.
+type FAQDump struct {
+	ExportedAt time.Time   `json:"exported_at" yaml:"exported_at"`
+	FAQs       []FAQRecord `json:"faqs" yaml:"faqs"`
+}
+
+// @Description ImportErrorResponse describes a rejected row of an import.
+type ImportErrorResponse struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// @Description ImportResultResponse describes the outcome of an import. Nothing is stored when errors is not empty or dry_run is true.
+type ImportResultResponse struct {
+	DryRun    bool                  `json:"dry_run"`
+	Created   int                   `json:"created"`
+	Updated   int                   `json:"updated"`
+	Deleted   int                   `json:"deleted"`
+	Unchanged int                   `json:"unchanged"`
+	Errors    []ImportErrorResponse `json:"errors"`
+}
+
+// @Description ImportResponse wraps the outcome of an import.
+type ImportResponse struct {
+	Data ImportResultResponse `json:"data"`
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// sameTime compares times at the microsecond precision they are stored
+// with.
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Truncate(time.Microsecond).Equal(b.Truncate(time.Microsecond))
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// @Description Translation is FAQ content in a non-default locale.
+type Translation struct {
+	FAQID     uuid.UUID
+	Locale    string
+	Title     string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// @Description UpsertTranslationRequest describes request body for creating or updating a translation.
+type UpsertTranslationRequest struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+type UpsertTranslationInput struct {
+	FAQID   uuid.UUID
+	Locale  string
+	Title   string
+	Content string
+}
+
+// @Description TranslationFullResponse is a full translation representation.
+type TranslationFullResponse struct {
+	FAQID   uuid.UUID `json:"faq_id"`
+	Locale  string    `json:"locale"`
+	Title   string    `json:"title"`
+	Content string    `json:"content"`
+}
+
+// @Description TranslationListResponse wraps a translation list response.
+type TranslationListResponse struct {
+	Data []TranslationFullResponse `json:"data"`
+}
+
+// @Description TranslationResponse wraps a single translation response.
+type TranslationResponse struct {
+	Data TranslationFullResponse `json:"data"`
+}
@@ -0,0 +1,11 @@
+package domain
+
+// @Description PurgeResult reports how many FAQs were permanently removed.
+type PurgeResult struct {
+	Purged int `json:"purged"`
+}
+
+// @Description PurgeResponse wraps a purge result.
+type PurgeResponse struct {
+	Data PurgeResult `json:"data"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+// FAQStatus is the editorial state of a FAQ. Once a FAQ has been published
+// its live content keeps being served while the next version goes through
+// draft and review; archiving takes it off the site.
+type FAQStatus string
+
+const (
+	StatusDraft     FAQStatus = "draft"
+	StatusInReview  FAQStatus = "in_review"
+	StatusPublished FAQStatus = "published"
+	StatusArchived  FAQStatus = "archived"
+)
+
+func (s FAQStatus) Valid() bool {
+	switch s {
+	case StatusDraft, StatusInReview, StatusPublished, StatusArchived:
+		return true
+	}
+	return false
+}
+
+// FAQDraft is pending content that replaces the live content on publish.
+type FAQDraft struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// @Description SaveDraftRequest describes request body for saving draft content.
+type SaveDraftRequest struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// @Description ChangeStatusRequest describes request body for a workflow transition.
+type ChangeStatusRequest struct {
+	Status string `json:"status" enums:"draft,in_review,published,archived"`
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const apiKeyColumns = `id, tenant, name, role, key_hash, created_at, revoked_at`
+
+type APIKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	const q = `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE tenant = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.APIKey, 0)
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate api keys: %w", err)
+	}
+	return out, nil
+}
+
+// GetByHash looks a key up in every tenant: the key decides the tenant of
+// the request, not the other way round.
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	const q = `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE key_hash = $1
+	`
+
+	out, err := scanAPIKey(r.db.QueryRowContext(ctx, q, hash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.APIKey{}, domain.ErrNotFound
+		}
+		return domain.APIKey{}, fmt.Errorf("get api key: %w", err)
+	}
+	return out, nil
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, in domain.CreateAPIKeyInput) (domain.APIKey, error) {
+	if err := validateAPIKeyInput(in); err != nil {
+		return domain.APIKey{}, err
+	}
+	const q = `
+		INSERT INTO api_keys (tenant, name, role, key_hash)
+		VALUES ($4, $1, $2, $3)
+		RETURNING ` + apiKeyColumns
+
+	out, err := scanAPIKey(r.db.QueryRowContext(ctx, q, in.Name, string(in.Role), in.Hash, domain.TenantFromContext(ctx)))
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("create api key: %w", err)
+	}
+	return out, nil
+}
+
+// Revoke disables a key. Revoking a revoked key keeps its revoked_at.
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	const q = `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, now())
+		WHERE id = $1 AND tenant = $2
+	`
+
+	res, err := r.db.ExecContext(ctx, q, id.String(), domain.TenantFromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("revoke api key: rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func scanAPIKey(s rowScanner) (domain.APIKey, error) {
+	var (
+		out       domain.APIKey
+		idRaw     string
+		revokedAt sql.NullTime
+	)
+	if err := s.Scan(&idRaw, &out.Tenant, &out.Name, &out.Role, &out.Hash, &out.CreatedAt, &revokedAt); err != nil {
+		return domain.APIKey{}, fmt.Errorf("scan api key: %w", err)
+	}
+	id, err := uuid.Parse(idRaw)
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("parse api key id: %w", err)
+	}
+	out.ID = id
+	out.RevokedAt = timePtr(revokedAt)
+	return out, nil
+}
+
+func validateAPIKeyInput(in domain.CreateAPIKeyInput) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return domain.ValidationError{Message: "name is required"}
+	}
+	if !in.Role.Valid() {
+		return domain.ValidationError{Message: "role must be viewer, editor, publisher or admin"}
+	}
+	if in.Hash == "" {
+		return domain.ValidationError{Message: "hash is required"}
+	}
+	return nil
+}
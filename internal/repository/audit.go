@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const auditColumns = `id, action, actor, faq_id, locale, before, after, request_id, client_ip, created_at`
+
+type AuditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, in domain.CreateAuditEventInput) (domain.AuditEvent, error) {
+	if err := validateAuditInput(in); err != nil {
+		return domain.AuditEvent{}, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	out, err := appendAuditEvent(ctx, tx, in)
+	if err != nil {
+		return domain.AuditEvent{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// insertAuditEvent records a change of a FAQ in the audit log. It must run
+// in the transaction that made the change, so that the change is never
+// stored without its event.
+func insertAuditEvent(ctx context.Context, tx *sql.Tx, action domain.AuditAction, before, after *domain.FAQ) error {
+	_, err := appendAuditEvent(ctx, tx, domain.NewAuditEventInput(ctx, action, before, after))
+	return err
+}
+
+func appendAuditEvent(ctx context.Context, tx *sql.Tx, in domain.CreateAuditEventInput) (domain.AuditEvent, error) {
+	before, err := encodeSnapshot(in.Before)
+	if err != nil {
+		return domain.AuditEvent{}, err
+	}
+	after, err := encodeSnapshot(in.After)
+	if err != nil {
+		return domain.AuditEvent{}, err
+	}
+	const q = `
+		INSERT INTO audit_events (tenant, action, actor, faq_id, locale, before, after, request_id, client_ip)
+		VALUES ($8, $1, $2, $3, $9, $4, $5, $6, $7)
+		RETURNING ` + auditColumns
+
+	out, err := scanAuditEvent(tx.QueryRowContext(ctx, q, string(in.Action), in.Actor, in.FAQID.String(),
+		before, after, in.RequestID, in.ClientIP, domain.TenantFromContext(ctx), in.Locale))
+	if err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("append audit event: %w", err)
+	}
+	return out, nil
+}
+
+// List returns audit events newest first, with the ID as a tie-breaker,
+// so the list can be continued from a cursor.
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	var actor, cursorTime, cursorID any
+	if filter.Actor != "" {
+		actor = filter.Actor
+	}
+	if filter.After != nil {
+		cursorTime, cursorID = filter.After.CreatedAt, filter.After.ID.String()
+	}
+
+	const q = `
+		SELECT ` + auditColumns + `
+		FROM audit_events
+		WHERE tenant = $8
+		  AND ($1::text IS NULL OR actor = $1)
+		  AND ($2::uuid IS NULL OR faq_id = $2::uuid)
+		  AND ($3::timestamptz IS NULL OR created_at >= $3)
+		  AND ($4::timestamptz IS NULL OR created_at < $4)
+		  AND ($6::uuid IS NULL OR (created_at, id) < ($5::timestamptz, $6::uuid))
+		ORDER BY created_at DESC, id DESC
+		LIMIT NULLIF($7, 0)
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, actor, nullUUID(filter.FAQID), filter.From, filter.To,
+		cursorTime, cursorID, filter.Limit, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list audit events: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.AuditEvent, 0)
+	for rows.Next() {
+		e, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit events: %w", err)
+	}
+	return out, nil
+}
+
+func scanAuditEvent(s rowScanner) (domain.AuditEvent, error) {
+	var (
+		out           domain.AuditEvent
+		idRaw, faqRaw string
+		before, after []byte
+	)
+	if err := s.Scan(&idRaw, &out.Action, &out.Actor, &faqRaw, &out.Locale, &before, &after,
+		&out.RequestID, &out.ClientIP, &out.CreatedAt); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("scan audit event: %w", err)
+	}
+	var err error
+	if out.ID, err = uuid.Parse(idRaw); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("parse audit event id: %w", err)
+	}
+	if out.FAQID, err = uuid.Parse(faqRaw); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("parse audit event faq id: %w", err)
+	}
+	if out.Before, err = decodeSnapshot(before); err != nil {
+		return domain.AuditEvent{}, err
+	}
+	if out.After, err = decodeSnapshot(after); err != nil {
+		return domain.AuditEvent{}, err
+	}
+	return out, nil
+}
+
+// encodeSnapshot returns the JSON of a snapshot, or nil to store NULL.
+func encodeSnapshot(s *domain.FAQSnapshot) (any, error) {
+	if s == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("encode snapshot: %w", err)
+	}
+	return string(raw), nil
+}
+
+func decodeSnapshot(raw []byte) (*domain.FAQSnapshot, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var s domain.FAQSnapshot
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+	return &s, nil
+}
+
+func validateAuditInput(in domain.CreateAuditEventInput) error {
+	if !in.Action.Valid() {
+		return domain.ValidationError{Message: "action must be create, update, delete, restore or purge"}
+	}
+	if in.FAQID == uuid.Nil {
+		return domain.ValidationError{Message: "faq_id is required"}
+	}
+	return nil
+}
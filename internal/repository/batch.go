@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Batch applies the operations in order in one transaction, which is
+// committed only when all of them succeed. The error of a failed operation
+// is wrapped in a domain.BatchError. Deletes yield the trashed FAQ.
+func (r *FAQRepository) Batch(ctx context.Context, ops []domain.BatchOperation) ([]domain.FAQ, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	out := make([]domain.FAQ, 0, len(ops))
+	for i, op := range ops {
+		f, err := applyBatchOperation(ctx, tx, op)
+		if err != nil {
+			return nil, domain.BatchError{Index: i, Err: err}
+		}
+		out = append(out, f)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+func applyBatchOperation(ctx context.Context, tx *sql.Tx, op domain.BatchOperation) (domain.FAQ, error) {
+	switch op.Action {
+	case domain.BatchCreate:
+		if err := validateFAQInput(op.Create.Title, op.Create.Content, op.Create.Position); err != nil {
+			return domain.FAQ{}, err
+		}
+		return createFAQ(ctx, tx, op.Create)
+	case domain.BatchUpdate:
+		if err := validateFAQID(op.ID); err != nil {
+			return domain.FAQ{}, err
+		}
+		if err := validateFAQInput(op.Update.Title, op.Update.Content, op.Update.Position); err != nil {
+			return domain.FAQ{}, err
+		}
+		return updateFAQ(ctx, tx, op.ID, op.Update)
+	case domain.BatchDelete:
+		if err := validateFAQID(op.ID); err != nil {
+			return domain.FAQ{}, err
+		}
+		return deleteFAQ(ctx, tx, op.ID)
+	}
+	return domain.FAQ{}, domain.ValidationError{Message: "op must be create, update or delete"}
+}
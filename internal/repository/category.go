@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const categoryColumns = `id, parent_id, name, slug, position, created_at, updated_at`
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+func (r *CategoryRepository) List(ctx context.Context) ([]domain.Category, error) {
+	const q = `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE tenant = $1
+		ORDER BY position ASC, name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list categories: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.Category, 0)
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate categories: %w", err)
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
+	if err := validateCategoryID(id); err != nil {
+		return domain.Category{}, err
+	}
+	const q = `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE id = $1 AND tenant = $2
+	`
+
+	out, err := scanCategory(r.db.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrNotFound
+		}
+		return domain.Category{}, fmt.Errorf("get category: %w", err)
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, in domain.CreateCategoryInput) (domain.Category, error) {
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	const q = `
+		INSERT INTO categories (tenant, parent_id, name, slug, position)
+		VALUES ($5, $1, $2, $3, $4)
+		RETURNING ` + categoryColumns
+
+	out, err := scanCategory(r.db.QueryRowContext(ctx, q, nullUUID(in.ParentID), in.Name, in.Slug, in.Position,
+		domain.TenantFromContext(ctx)))
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("create category: %w", mapWriteError(err))
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, id uuid.UUID, in domain.UpdateCategoryInput) (domain.Category, error) {
+	if err := validateCategoryID(id); err != nil {
+		return domain.Category{}, err
+	}
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	const q = `
+		UPDATE categories
+		SET parent_id = $2, name = $3, slug = $4, position = $5, updated_at = now()
+		WHERE id = $1 AND tenant = $6
+		RETURNING ` + categoryColumns
+
+	out, err := scanCategory(r.db.QueryRowContext(ctx, q, id.String(), nullUUID(in.ParentID), in.Name, in.Slug, in.Position,
+		domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrNotFound
+		}
+		return domain.Category{}, fmt.Errorf("update category: %w", mapWriteError(err))
+	}
+	return out, nil
+}
+
+// Delete removes a category. Its subcategories become top-level and its
+// FAQs move to the end of the FAQs without a category.
+func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := validateCategoryID(id); err != nil {
+		return err
+	}
+	const (
+		lockQ = `
+			SELECT ` + faqColumns + `
+			FROM faqs
+			WHERE tenant = $2 AND category_id = $1
+			FOR UPDATE
+		`
+		// positions are parked negative so that the unique (category_id,
+		// position) index holds while the FAQs join the uncategorized group
+		detachQ = `
+			UPDATE faqs
+			SET category_id = NULL, version = version + 1, updated_at = now(),
+				position = -(position + (
+					SELECT COALESCE(MAX(position), 0) FROM faqs
+					WHERE tenant = $2 AND category_id IS NULL AND deleted_at IS NULL
+				))
+			WHERE tenant = $2 AND category_id = $1
+		`
+		flipQ = `
+			UPDATE faqs
+			SET position = -position
+			WHERE tenant = $1 AND category_id IS NULL AND position < 0
+			RETURNING ` + faqColumns
+		// the foreign key would detach subcategories as well, but without
+		// touching their update time
+		orphanQ = `UPDATE categories SET parent_id = NULL, updated_at = now() WHERE tenant = $2 AND parent_id = $1`
+		deleteQ = `DELETE FROM categories WHERE id = $1 AND tenant = $2`
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	tenant := domain.TenantFromContext(ctx)
+	befores, err := collectFAQs(tx.QueryContext(ctx, lockQ, id.String(), tenant))
+	if err != nil {
+		return fmt.Errorf("lock faqs: %w", err)
+	}
+	before := make(map[uuid.UUID]domain.FAQ, len(befores))
+	for _, faq := range befores {
+		before[faq.ID] = faq
+	}
+	if _, err := tx.ExecContext(ctx, detachQ, id.String(), tenant); err != nil {
+		return fmt.Errorf("detach faqs: %w", err)
+	}
+	detached, err := collectFAQs(tx.QueryContext(ctx, flipQ, tenant))
+	if err != nil {
+		return fmt.Errorf("detach faqs: %w", err)
+	}
+	for _, faq := range detached {
+		if err := insertRevision(ctx, tx, domain.RevisionUpdate, faq); err != nil {
+			return err
+		}
+		prev := before[faq.ID]
+		if err := insertAuditEvent(ctx, tx, domain.AuditUpdate, &prev, &faq); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, orphanQ, id.String(), tenant); err != nil {
+		return fmt.Errorf("detach subcategories: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, deleteQ, id.String(), tenant)
+	if err != nil {
+		return fmt.Errorf("delete category: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete category: rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+func scanCategory(s rowScanner) (domain.Category, error) {
+	var (
+		out      domain.Category
+		idRaw    string
+		parentID uuid.NullUUID
+	)
+	err := s.Scan(&idRaw, &parentID, &out.Name, &out.Slug, &out.Position, &out.CreatedAt, &out.UpdatedAt)
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("scan category: %w", err)
+	}
+	id, err := uuid.Parse(idRaw)
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("parse category id: %w", err)
+	}
+	out.ID = id
+	out.ParentID = uuidPtr(parentID)
+	return out, nil
+}
+
+func validateCategoryID(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	return nil
+}
+
+func validateCategoryInput(name, slug string, position int) error {
+	if strings.TrimSpace(name) == "" {
+		return domain.ValidationError{Message: "name is required"}
+	}
+	if strings.TrimSpace(slug) == "" {
+		return domain.ValidationError{Message: "slug is required"}
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
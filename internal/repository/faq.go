@@ -5,12 +5,25 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"html"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/nightmaker00/accordion-go/internal/domain"
 )
 
+const faqColumns = `id, category_id, title, content, content_format, position, is_active,
+	status, draft_title, draft_content, published_at, publish_at, expire_at, deleted_at, version, created_at, updated_at`
+
+// faqPublicCondition selects FAQs whose live content may be served at the
+// moment passed as $1.
+const faqPublicCondition = `deleted_at IS NULL
+	AND is_active = true AND published_at IS NOT NULL AND status <> 'archived'
+	AND (publish_at IS NULL OR publish_at <= $1)
+	AND (expire_at IS NULL OR expire_at > $1)`
+
 type FAQRepository struct {
 	db *sql.DB
 }
@@ -19,46 +32,60 @@ func NewFAQRepository(db *sql.DB) *FAQRepository {
 	return &FAQRepository{db: db}
 }
 
-func (r *FAQRepository) ListActive(ctx context.Context) ([]domain.FAQ, error) {
+func (r *FAQRepository) ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error) {
 	const q = `
-		SELECT id, title, content, position
+		SELECT ` + faqColumns + `
 		FROM faqs
-		WHERE is_active = true
+		WHERE tenant = $3 AND ` + faqPublicCondition + `
+		  AND ($2::uuid IS NULL OR category_id = $2::uuid)
 		ORDER BY position ASC
 	`
 
-	rows, err := r.db.QueryContext(ctx, q)
+	// read first, so that a change landing in between leaves the time
+	// behind the list rather than ahead of it
+	modified, err := r.lastModified(ctx, filter.At)
+	if err != nil {
+		return domain.ActiveFAQs{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, filter.At, nullUUID(filter.CategoryID), domain.TenantFromContext(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("list active faqs: %w", err)
+		return domain.ActiveFAQs{}, fmt.Errorf("list active faqs: %w", err)
 	}
 	defer rows.Close()
 
 	out := make([]domain.FAQ, 0)
 	for rows.Next() {
-		var (
-			idRaw    string
-			title    string
-			content  string
-			position int
-		)
-		if err := rows.Scan(&idRaw, &title, &content, &position); err != nil {
-			return nil, fmt.Errorf("scan faq: %w", err)
-		}
-		id, err := uuid.Parse(idRaw)
+		faq, err := scanFAQ(rows)
 		if err != nil {
-			return nil, fmt.Errorf("parse faq id: %w", err)
+			return domain.ActiveFAQs{}, err
 		}
-		out = append(out, domain.FAQ{
-			ID:       id,
-			Title:    title,
-			Content:  content,
-			Position: position,
-		})
+		out = append(out, faq)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate faqs: %w", err)
+		return domain.ActiveFAQs{}, fmt.Errorf("iterate faqs: %w", err)
 	}
-	return out, nil
+	return domain.ActiveFAQs{Items: out, LastModified: modified}, nil
+}
+
+// lastModified returns when the public FAQs of the tenant last changed as
+// of at: the latest audited change of a FAQ or translation, publish_at or
+// expire_at passed by at, or category update.
+func (r *FAQRepository) lastModified(ctx context.Context, at time.Time) (time.Time, error) {
+	const q = `
+		SELECT GREATEST(
+			(SELECT MAX(created_at) FROM audit_events WHERE tenant = $2),
+			(SELECT MAX(publish_at) FROM faqs WHERE tenant = $2 AND publish_at <= $1),
+			(SELECT MAX(expire_at) FROM faqs WHERE tenant = $2 AND expire_at <= $1),
+			(SELECT MAX(updated_at) FROM categories WHERE tenant = $2)
+		)
+	`
+
+	var out sql.NullTime
+	if err := r.db.QueryRowContext(ctx, q, at, domain.TenantFromContext(ctx)).Scan(&out); err != nil {
+		return time.Time{}, fmt.Errorf("last modified: %w", err)
+	}
+	return out.Time, nil
 }
 
 func (r *FAQRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
@@ -66,26 +93,18 @@ func (r *FAQRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ,
 		return domain.FAQ{}, err
 	}
 	const q = `
-		SELECT id, title, content, position, is_active, created_at, updated_at
+		SELECT ` + faqColumns + `
 		FROM faqs
-		WHERE id = $1
+		WHERE id = $1 AND tenant = $2 AND deleted_at IS NULL
 	`
 
-	var out domain.FAQ
-	var idRaw string
-	err := r.db.QueryRowContext(ctx, q, id.String()).
-		Scan(&idRaw, &out.Title, &out.Content, &out.Position, &out.IsActive, &out.CreatedAt, &out.UpdatedAt)
+	out, err := scanFAQ(r.db.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.FAQ{}, domain.ErrNotFound
 		}
 		return domain.FAQ{}, fmt.Errorf("get faq: %w", err)
 	}
-	parsedID, err := uuid.Parse(idRaw)
-	if err != nil {
-		return domain.FAQ{}, fmt.Errorf("parse faq id: %w", err)
-	}
-	out.ID = parsedID
 	return out, nil
 }
 
@@ -93,25 +112,22 @@ func (r *FAQRepository) Create(ctx context.Context, in domain.CreateFAQInput) (d
 	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
 		return domain.FAQ{}, err
 	}
-	const q = `
-		INSERT INTO faqs (title, content, position, is_active)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, title, content, position, is_active, created_at, updated_at
-	`
 
-	var out domain.FAQ
-	var idRaw string
-	err := r.db.QueryRowContext(ctx, q, in.Title, in.Content, in.Position, in.IsActive).
-		Scan(&idRaw, &out.Title, &out.Content, &out.Position, &out.IsActive, &out.CreatedAt, &out.UpdatedAt)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return domain.FAQ{}, fmt.Errorf("create faq: %w", err)
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
 	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-	parsedID, err := uuid.Parse(idRaw)
+	out, err := createFAQ(ctx, tx, in)
 	if err != nil {
-		return domain.FAQ{}, fmt.Errorf("parse faq id: %w", err)
+		return domain.FAQ{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
 	}
-	out.ID = parsedID
 	return out, nil
 }
 
@@ -122,12 +138,6 @@ func (r *FAQRepository) Update(ctx context.Context, id uuid.UUID, in domain.Upda
 	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
 		return domain.FAQ{}, err
 	}
-	const q = `
-		UPDATE faqs
-		SET title = $2, content = $3, position = $4, is_active = $5, updated_at = now()
-		WHERE id = $1
-		RETURNING id, title, content, position, is_active, created_at, updated_at
-	`
 
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -137,34 +147,22 @@ func (r *FAQRepository) Update(ctx context.Context, id uuid.UUID, in domain.Upda
 		_ = tx.Rollback()
 	}()
 
-	var out domain.FAQ
-	var idRaw string
-	err = tx.QueryRowContext(ctx, q, id.String(), in.Title, in.Content, in.Position, in.IsActive).
-		Scan(&idRaw, &out.Title, &out.Content, &out.Position, &out.IsActive, &out.CreatedAt, &out.UpdatedAt)
+	out, err := updateFAQ(ctx, tx, id, in)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return domain.FAQ{}, domain.ErrNotFound
-		}
-		return domain.FAQ{}, fmt.Errorf("update faq: %w", err)
-	}
-
-	parsedID, err := uuid.Parse(idRaw)
-	if err != nil {
-		return domain.FAQ{}, fmt.Errorf("parse faq id: %w", err)
+		return domain.FAQ{}, err
 	}
-	out.ID = parsedID
-
 	if err := tx.Commit(); err != nil {
 		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
 	}
 	return out, nil
 }
 
+// Delete moves a FAQ to the trash. Trashed FAQs are invisible to every
+// other query until restored or purged.
 func (r *FAQRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := validateFAQID(id); err != nil {
 		return err
 	}
-	const q = `DELETE FROM faqs WHERE id = $1`
 
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -174,21 +172,304 @@ func (r *FAQRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		_ = tx.Rollback()
 	}()
 
-	res, err := tx.ExecContext(ctx, q, id.String())
-	if err != nil {
-		return fmt.Errorf("delete faq: %w", err)
-	}
-	affected, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("delete faq: rows affected: %w", err)
+	if _, err := deleteFAQ(ctx, tx, id); err != nil {
+		return err
 	}
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("commit tx: %w", err)
 	}
-	_ = affected
 	return nil
 }
 
+// createFAQ inserts a FAQ, its first revision and its audit event within
+// tx.
+func createFAQ(ctx context.Context, tx *sql.Tx, in domain.CreateFAQInput) (domain.FAQ, error) {
+	const q = `
+		INSERT INTO faqs (tenant, category_id, title, content, content_format, position, is_active, status, published_at,
+			publish_at, expire_at)
+		VALUES ($9, $1, $2, $3, COALESCE(NULLIF($10, ''), 'plain'), $4, $5, $6, CASE WHEN $6 = 'published' THEN now() END, $7, $8)
+		RETURNING ` + faqColumns
+
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, nullUUID(in.CategoryID), in.Title, in.Content, in.Position, in.IsActive,
+		string(in.Status), in.PublishAt, in.ExpireAt, domain.TenantFromContext(ctx), string(in.ContentFormat)))
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("create faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionCreate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, domain.AuditCreate, nil, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+	return out, nil
+}
+
+// updateFAQ replaces the editable fields of a live FAQ within tx.
+func updateFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
+	const q = `
+		UPDATE faqs
+		SET category_id = $2, title = $3, content = $4, content_format = COALESCE(NULLIF($11, ''), content_format),
+			position = $5, is_active = $6, publish_at = $7, expire_at = $8, version = version + 1, updated_at = now()
+		WHERE id = $1 AND tenant = $10 AND deleted_at IS NULL AND ($9 = 0 OR version = $9)
+		RETURNING ` + faqColumns
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), nullUUID(in.CategoryID), in.Title, in.Content, in.Position, in.IsActive,
+		in.PublishAt, in.ExpireAt, in.Version, domain.TenantFromContext(ctx), string(in.ContentFormat)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, notUpdated(ctx, tx, id)
+		}
+		return domain.FAQ{}, fmt.Errorf("update faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionUpdate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, domain.AuditUpdate, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+	return out, nil
+}
+
+// deleteFAQ moves a live FAQ to the trash within tx and returns it.
+func deleteFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID) (domain.FAQ, error) {
+	const q = `
+		UPDATE faqs
+		SET deleted_at = now(), version = version + 1, updated_at = now()
+		WHERE id = $1
+		RETURNING ` + faqColumns
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	deleted, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String()))
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("delete faq: %w", err)
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionDelete, deleted); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, domain.AuditDelete, &before, nil); err != nil {
+		return domain.FAQ{}, err
+	}
+	return deleted, nil
+}
+
+// lockFAQ returns a live FAQ of the context's tenant and locks its row
+// until tx ends, so that it stays as read until the change is written.
+func lockFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID) (domain.FAQ, error) {
+	out, err := lockStoredFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if out.DeletedAt != nil {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	return out, nil
+}
+
+// lockStoredFAQ is lockFAQ for FAQs in the trash as well.
+func lockStoredFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID) (domain.FAQ, error) {
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE id = $1 AND tenant = $2
+		FOR UPDATE
+	`
+
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("lock faq: %w", err)
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) Search(ctx context.Context, in domain.SearchQuery) ([]domain.SearchResult, error) {
+	const q = `
+		WITH query AS (
+			SELECT websearch_to_tsquery($2::regconfig, $3) AS q
+		)
+		SELECT ` + faqColumns + `,
+			ts_rank_cd(search_vector, query.q) AS rank,
+			ts_headline($2::regconfig, title, query.q, $4),
+			ts_headline($2::regconfig, content, query.q, $5)
+		FROM faqs, query
+		WHERE tenant = $8 AND ` + faqPublicCondition + `
+		  AND search_vector @@ query.q
+		  AND ($6::uuid IS NULL OR category_id = $6::uuid)
+		ORDER BY rank DESC, position ASC
+		LIMIT NULLIF($7, 0)
+	`
+
+	titleOpts := fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", highlightStart, highlightStop)
+	contentOpts := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=35, MinWords=15, MaxFragments=2", highlightStart, highlightStop)
+
+	rows, err := r.db.QueryContext(ctx, q, in.At, in.Language, in.Query, titleOpts, contentOpts, nullUUID(in.CategoryID), in.Limit,
+		domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("search faqs: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.SearchResult, 0)
+	for rows.Next() {
+		var (
+			res     domain.SearchResult
+			title   string
+			content string
+		)
+		res.FAQ, err = scanFAQ(rows, &res.Rank, &title, &content)
+		if err != nil {
+			return nil, err
+		}
+		res.TitleHighlight = renderHighlight(title)
+		res.ContentHighlight = renderHighlight(content)
+		out = append(out, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate search results: %w", err)
+	}
+	return out, nil
+}
+
+// notUpdated explains why a versioned update matched no row: the FAQ is
+// gone or it has been changed since the expected version.
+func notUpdated(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	const q = `SELECT version FROM faqs WHERE id = $1 AND tenant = $2 AND deleted_at IS NULL`
+
+	var version int
+	if err := tx.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get faq version: %w", err)
+	}
+	return domain.VersionConflictError{Current: version}
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanFAQ scans faqColumns followed by any extra selected columns.
+func scanFAQ(s rowScanner, extra ...any) (domain.FAQ, error) {
+	var (
+		out          domain.FAQ
+		idRaw        string
+		categoryID   uuid.NullUUID
+		format       string
+		status       string
+		draftTitle   sql.NullString
+		draftContent sql.NullString
+		publishedAt  sql.NullTime
+		publishAt    sql.NullTime
+		expireAt     sql.NullTime
+		deletedAt    sql.NullTime
+	)
+	dest := []any{&idRaw, &categoryID, &out.Title, &out.Content, &format, &out.Position, &out.IsActive,
+		&status, &draftTitle, &draftContent, &publishedAt, &publishAt, &expireAt, &deletedAt, &out.Version, &out.CreatedAt, &out.UpdatedAt}
+	if err := s.Scan(append(dest, extra...)...); err != nil {
+		return domain.FAQ{}, fmt.Errorf("scan faq: %w", err)
+	}
+	id, err := uuid.Parse(idRaw)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("parse faq id: %w", err)
+	}
+	out.ID = id
+	out.CategoryID = uuidPtr(categoryID)
+	out.ContentFormat = domain.ContentFormat(format)
+	out.Status = domain.FAQStatus(status)
+	if draftTitle.Valid && draftContent.Valid {
+		out.Draft = &domain.FAQDraft{Title: draftTitle.String, Content: draftContent.String}
+	}
+	out.PublishedAt = timePtr(publishedAt)
+	out.PublishAt = timePtr(publishAt)
+	out.ExpireAt = timePtr(expireAt)
+	out.DeletedAt = timePtr(deletedAt)
+	return out, nil
+}
+
+// ts_headline does not escape the document, so matches are delimited with
+// control characters and turned into <mark> tags after escaping.
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+func renderHighlight(s string) string {
+	s = html.EscapeString(s)
+	s = strings.ReplaceAll(s, highlightStart, "<mark>")
+	return strings.ReplaceAll(s, highlightStop, "</mark>")
+}
+
+// mapWriteError turns constraint violations into domain errors.
+func mapWriteError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23503": // foreign_key_violation
+			if strings.Contains(pqErr.Constraint, "category") || strings.Contains(pqErr.Constraint, "parent") {
+				return domain.ValidationError{Message: "category not found"}
+			}
+		case "23514": // check_violation
+			if strings.Contains(pqErr.Constraint, "schedule") {
+				return domain.ValidationError{Message: "expire_at must be after publish_at"}
+			}
+			if strings.Contains(pqErr.Constraint, "content_format") {
+				return domain.ValidationError{Message: "content_format must be plain, markdown or html"}
+			}
+		case "23505": // unique_violation
+			if strings.Contains(pqErr.Constraint, "slug") {
+				return domain.ValidationError{Message: "slug already exists"}
+			}
+			if strings.Contains(pqErr.Constraint, "position") {
+				return domain.ValidationError{Message: "position is already taken in the category"}
+			}
+			if strings.Contains(pqErr.Constraint, "faq_revisions") {
+				return domain.ErrConflict
+			}
+		}
+	}
+	return err
+}
+
+func nullUUID(id *uuid.UUID) any {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
+
+func timePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+func uuidPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	v := id.UUID
+	return &v
+}
+
+func sameCategory(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
 func validateFAQID(id uuid.UUID) error {
 	if id == uuid.Nil {
 		return domain.ValidationError{Message: "id is required"}
@@ -197,14 +478,21 @@ func validateFAQID(id uuid.UUID) error {
 }
 
 func validateFAQInput(title, content string, position int) error {
+	if err := validateContent(title, content); err != nil {
+		return err
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
+
+func validateContent(title, content string) error {
 	if strings.TrimSpace(title) == "" {
 		return domain.ValidationError{Message: "title is required"}
 	}
 	if strings.TrimSpace(content) == "" {
 		return domain.ValidationError{Message: "content is required"}
 	}
-	if position <= 0 {
-		return domain.ValidationError{Message: "position must be greater than 0"}
-	}
 	return nil
 }
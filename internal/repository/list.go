@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// listSortColumns maps sort keys to the column and the type of its cursor
+// value.
+var listSortColumns = map[domain.FAQSortKey]struct {
+	column string
+	cast   string
+}{
+	domain.SortByPosition:  {"position", "int"},
+	domain.SortByCreatedAt: {"created_at", "timestamptz"},
+	domain.SortByUpdatedAt: {"updated_at", "timestamptz"},
+	domain.SortByTitle:     {"title", "text"},
+}
+
+// List returns FAQs for the admin list regardless of their status and
+// schedule. Trashed FAQs are excluded. Rows are ordered by the sort key with
+// the ID as a tie-breaker, so the list can be continued from a cursor.
+func (r *FAQRepository) List(ctx context.Context, filter domain.FAQListFilter) ([]domain.FAQ, error) {
+	sort, ok := listSortColumns[filter.Sort]
+	if !ok {
+		return nil, domain.ValidationError{Message: "sort is invalid"}
+	}
+	dir, cmp := "ASC", ">"
+	if filter.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	var status, query, cursorValue, cursorID any
+	if filter.Status != "" {
+		status = string(filter.Status)
+	}
+	if filter.Query != "" {
+		query = "%" + escapeLike(filter.Query) + "%"
+	}
+	if filter.After != nil {
+		cursorValue, cursorID = filter.After.Value, filter.After.ID.String()
+	}
+
+	q := `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE tenant = $12 AND deleted_at IS NULL
+		  AND ($1::boolean IS NULL OR is_active = $1)
+		  AND ($2::text IS NULL OR status = $2)
+		  AND ($3::uuid IS NULL OR category_id = $3::uuid)
+		  AND ($4::timestamptz IS NULL OR created_at >= $4)
+		  AND ($5::timestamptz IS NULL OR created_at < $5)
+		  AND ($6::timestamptz IS NULL OR updated_at >= $6)
+		  AND ($7::timestamptz IS NULL OR updated_at < $7)
+		  AND ($8::text IS NULL OR title ILIKE $8 OR content ILIKE $8)
+		  AND ($10::uuid IS NULL OR (` + sort.column + `, id) ` + cmp + ` ($9::` + sort.cast + `, $10::uuid))
+		ORDER BY ` + sort.column + ` ` + dir + `, id ` + dir + `
+		LIMIT NULLIF($11, 0)
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, filter.IsActive, status, nullUUID(filter.CategoryID),
+		filter.CreatedFrom, filter.CreatedTo, filter.UpdatedFrom, filter.UpdatedTo, query,
+		cursorValue, cursorID, filter.Limit, domain.TenantFromContext(ctx))
+	out, err := collectFAQs(rows, err)
+	if err != nil {
+		return nil, fmt.Errorf("list faqs: %w", err)
+	}
+	return out, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
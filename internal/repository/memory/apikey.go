@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type APIKeyRepository struct {
+	s *Store
+}
+
+func NewAPIKeyRepository(s *Store) *APIKeyRepository {
+	return &APIKeyRepository{s: s}
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	out := make([]domain.APIKey, 0)
+	for _, k := range r.s.apiKeys {
+		if k.Tenant == tenant {
+			out = append(out, cloneAPIKey(k))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return compareUUID(out[i].ID, out[j].ID) < 0
+	})
+	return out, nil
+}
+
+// GetByHash looks a key up in every tenant: the key decides the tenant of
+// the request, not the other way round.
+func (r *APIKeyRepository) GetByHash(_ context.Context, hash string) (domain.APIKey, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, k := range r.s.apiKeys {
+		if k.Hash == hash {
+			return cloneAPIKey(k), nil
+		}
+	}
+	return domain.APIKey{}, domain.ErrNotFound
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, in domain.CreateAPIKeyInput) (domain.APIKey, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return domain.APIKey{}, domain.ValidationError{Message: "name is required"}
+	}
+	if !in.Role.Valid() {
+		return domain.APIKey{}, domain.ValidationError{Message: "role must be viewer, editor, publisher or admin"}
+	}
+	if in.Hash == "" {
+		return domain.APIKey{}, domain.ValidationError{Message: "hash is required"}
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	k := domain.APIKey{
+		ID:        uuid.New(),
+		Tenant:    domain.TenantFromContext(ctx),
+		Name:      in.Name,
+		Role:      in.Role,
+		Hash:      in.Hash,
+		CreatedAt: r.s.timestamp(),
+	}
+	r.s.apiKeys[k.ID] = k
+	return cloneAPIKey(k), nil
+}
+
+// Revoke disables a key. Revoking a revoked key keeps its RevokedAt.
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	k, ok := r.s.apiKeys[id]
+	if !ok || k.Tenant != domain.TenantFromContext(ctx) {
+		return domain.ErrNotFound
+	}
+	if k.RevokedAt == nil {
+		now := r.s.timestamp()
+		k.RevokedAt = &now
+		r.s.apiKeys[id] = k
+	}
+	return nil
+}
+
+func cloneAPIKey(k domain.APIKey) domain.APIKey {
+	k.RevokedAt = cloneTime(k.RevokedAt)
+	return k
+}
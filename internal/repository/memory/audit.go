@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type AuditRepository struct {
+	s *Store
+}
+
+func NewAuditRepository(s *Store) *AuditRepository {
+	return &AuditRepository{s: s}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, in domain.CreateAuditEventInput) (domain.AuditEvent, error) {
+	if !in.Action.Valid() {
+		return domain.AuditEvent{}, domain.ValidationError{Message: "action must be create, update, delete, restore or purge"}
+	}
+	if in.FAQID == uuid.Nil {
+		return domain.AuditEvent{}, domain.ValidationError{Message: "faq_id is required"}
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.appendAudit(ctx, in), nil
+}
+
+// insertAuditEvent records a change of a FAQ in the audit log. It must be
+// called with the write lock held, together with the change.
+func (s *Store) insertAuditEvent(ctx context.Context, action domain.AuditAction, before, after *domain.FAQ) {
+	s.appendAudit(ctx, domain.NewAuditEventInput(ctx, action, before, after))
+}
+
+// appendAudit stores an audit event of the context's tenant. The caller
+// holds the write lock.
+func (s *Store) appendAudit(ctx context.Context, in domain.CreateAuditEventInput) domain.AuditEvent {
+	e := domain.AuditEvent{
+		ID:        uuid.New(),
+		Action:    in.Action,
+		Actor:     in.Actor,
+		FAQID:     in.FAQID,
+		Locale:    in.Locale,
+		Before:    cloneSnapshotPtr(in.Before),
+		After:     cloneSnapshotPtr(in.After),
+		RequestID: in.RequestID,
+		ClientIP:  in.ClientIP,
+		CreatedAt: s.timestamp(),
+	}
+	s.audit = append(s.audit, e)
+	s.tenants[e.ID] = domain.TenantFromContext(ctx)
+	return cloneAuditEvent(e)
+}
+
+// List returns audit events newest first, with the ID as a tie-breaker,
+// so the list can be continued from a cursor.
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	out := make([]domain.AuditEvent, 0)
+	for _, e := range r.s.audit {
+		switch {
+		case r.s.tenants[e.ID] != tenant,
+			filter.Actor != "" && e.Actor != filter.Actor,
+			filter.FAQID != nil && e.FAQID != *filter.FAQID,
+			filter.From != nil && e.CreatedAt.Before(*filter.From),
+			filter.To != nil && !e.CreatedAt.Before(*filter.To),
+			filter.After != nil && !olderAuditEvent(e, filter.After.CreatedAt, filter.After.ID):
+			continue
+		}
+		out = append(out, cloneAuditEvent(e))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return olderAuditEvent(out[j], out[i].CreatedAt, out[i].ID)
+	})
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+// olderAuditEvent reports whether e follows the event with the given time
+// and ID in the newest first order.
+func olderAuditEvent(e domain.AuditEvent, createdAt time.Time, id uuid.UUID) bool {
+	if !e.CreatedAt.Equal(createdAt) {
+		return e.CreatedAt.Before(createdAt)
+	}
+	return compareUUID(e.ID, id) < 0
+}
+
+func cloneAuditEvent(e domain.AuditEvent) domain.AuditEvent {
+	e.Before = cloneSnapshotPtr(e.Before)
+	e.After = cloneSnapshotPtr(e.After)
+	return e
+}
+
+func cloneSnapshotPtr(s *domain.FAQSnapshot) *domain.FAQSnapshot {
+	if s == nil {
+		return nil
+	}
+	v := cloneSnapshot(*s)
+	return &v
+}
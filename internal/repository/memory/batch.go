@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Batch applies the operations in order under the write lock and puts the
+// store back as it was when one of them fails, wrapping its error in a
+// domain.BatchError. Deletes yield the trashed FAQ.
+func (r *FAQRepository) Batch(ctx context.Context, ops []domain.BatchOperation) ([]domain.FAQ, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	saved := r.s.saveFAQs()
+	out := make([]domain.FAQ, 0, len(ops))
+	for i, op := range ops {
+		f, err := r.s.applyBatchOperation(ctx, op)
+		if err != nil {
+			r.s.restoreFAQs(saved)
+			return nil, domain.BatchError{Index: i, Err: err}
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func (s *Store) applyBatchOperation(ctx context.Context, op domain.BatchOperation) (domain.FAQ, error) {
+	switch op.Action {
+	case domain.BatchCreate:
+		if err := validateFAQInput(op.Create.Title, op.Create.Content, op.Create.Position); err != nil {
+			return domain.FAQ{}, err
+		}
+		return s.createFAQ(ctx, op.Create)
+	case domain.BatchUpdate:
+		if err := validateID(op.ID); err != nil {
+			return domain.FAQ{}, err
+		}
+		if err := validateFAQInput(op.Update.Title, op.Update.Content, op.Update.Position); err != nil {
+			return domain.FAQ{}, err
+		}
+		return s.updateFAQ(ctx, op.ID, op.Update)
+	case domain.BatchDelete:
+		if err := validateID(op.ID); err != nil {
+			return domain.FAQ{}, err
+		}
+		return s.deleteFAQ(ctx, op.ID)
+	}
+	return domain.FAQ{}, domain.ValidationError{Message: "op must be create, update or delete"}
+}
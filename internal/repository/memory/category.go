@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type CategoryRepository struct {
+	s *Store
+}
+
+func NewCategoryRepository(s *Store) *CategoryRepository {
+	return &CategoryRepository{s: s}
+}
+
+func (r *CategoryRepository) List(ctx context.Context) ([]domain.Category, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	out := make([]domain.Category, 0)
+	for _, c := range r.s.categories {
+		if r.s.tenants[c.ID] == tenant {
+			out = append(out, cloneCategory(c))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Position != out[j].Position {
+			return out[i].Position < out[j].Position
+		}
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return compareUUID(out[i].ID, out[j].ID) < 0
+	})
+	return out, nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
+	if err := validateID(id); err != nil {
+		return domain.Category{}, err
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	c, ok := r.s.categoryOf(domain.TenantFromContext(ctx), id)
+	if !ok {
+		return domain.Category{}, domain.ErrNotFound
+	}
+	return cloneCategory(c), nil
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, in domain.CreateCategoryInput) (domain.Category, error) {
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.timestamp()
+	c := domain.Category{
+		ID:        uuid.New(),
+		ParentID:  cloneUUID(in.ParentID),
+		Name:      in.Name,
+		Slug:      in.Slug,
+		Position:  in.Position,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	tenant := domain.TenantFromContext(ctx)
+	if err := r.s.checkCategory(tenant, c); err != nil {
+		return domain.Category{}, err
+	}
+	r.s.categories[c.ID] = c
+	r.s.tenants[c.ID] = tenant
+	return cloneCategory(c), nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, id uuid.UUID, in domain.UpdateCategoryInput) (domain.Category, error) {
+	if err := validateID(id); err != nil {
+		return domain.Category{}, err
+	}
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	c, ok := r.s.categoryOf(tenant, id)
+	if !ok {
+		return domain.Category{}, domain.ErrNotFound
+	}
+	c.ParentID = cloneUUID(in.ParentID)
+	c.Name = in.Name
+	c.Slug = in.Slug
+	c.Position = in.Position
+	c.UpdatedAt = r.s.timestamp()
+	if err := r.s.checkCategory(tenant, c); err != nil {
+		return domain.Category{}, err
+	}
+	r.s.categories[c.ID] = c
+	return cloneCategory(c), nil
+}
+
+// Delete removes a category. Its subcategories become top-level and its
+// FAQs move to the end of the FAQs without a category.
+func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	if _, ok := r.s.categoryOf(tenant, id); !ok {
+		return domain.ErrNotFound
+	}
+
+	// only FAQs and subcategories of the same tenant can refer to it
+	shift := r.s.lastPosition(tenant, nil)
+	actor := domain.ActorFromContext(ctx)
+	now := r.s.timestamp()
+	for _, before := range r.s.faqs {
+		if before.CategoryID == nil || *before.CategoryID != id {
+			continue
+		}
+		f := before
+		f.CategoryID = nil
+		f.Position += shift
+		f.Version++
+		f.UpdatedAt = now
+		r.s.faqs[f.ID] = f
+		r.s.insertRevision(actor, domain.RevisionUpdate, f)
+		r.s.insertAuditEvent(ctx, domain.AuditUpdate, &before, &f)
+	}
+	for _, c := range r.s.categories {
+		if c.ParentID != nil && *c.ParentID == id {
+			c.ParentID = nil
+			c.UpdatedAt = now
+			r.s.categories[c.ID] = c
+		}
+	}
+	delete(r.s.categories, id)
+	delete(r.s.tenants, id)
+	return nil
+}
+
+// categoryOf returns a category of the tenant.
+func (s *Store) categoryOf(tenant string, id uuid.UUID) (domain.Category, bool) {
+	c, ok := s.categories[id]
+	if !ok || s.tenants[id] != tenant {
+		return domain.Category{}, false
+	}
+	return c, true
+}
+
+// checkCategory enforces the constraints the categories table has in
+// Postgres.
+func (s *Store) checkCategory(tenant string, c domain.Category) error {
+	if c.ParentID != nil {
+		if _, ok := s.categoryOf(tenant, *c.ParentID); !ok {
+			return domain.ValidationError{Message: "category not found"}
+		}
+	}
+	for _, other := range s.categories {
+		if other.ID != c.ID && other.Slug == c.Slug && s.tenants[other.ID] == tenant {
+			return domain.ValidationError{Message: "slug already exists"}
+		}
+	}
+	return nil
+}
+
+func validateCategoryInput(name, slug string, position int) error {
+	if strings.TrimSpace(name) == "" {
+		return domain.ValidationError{Message: "name is required"}
+	}
+	if strings.TrimSpace(slug) == "" {
+		return domain.ValidationError{Message: "slug is required"}
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
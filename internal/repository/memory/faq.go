@@ -0,0 +1,227 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type FAQRepository struct {
+	s *Store
+}
+
+func NewFAQRepository(s *Store) *FAQRepository {
+	return &FAQRepository{s: s}
+}
+
+func (r *FAQRepository) ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	items := r.s.liveFAQs(tenant, func(f domain.FAQ) bool {
+		return isPublic(f, filter.At) && (filter.CategoryID == nil || sameCategory(f.CategoryID, filter.CategoryID))
+	})
+	return domain.ActiveFAQs{Items: items, LastModified: r.s.lastModified(tenant, filter.At)}, nil
+}
+
+// lastModified returns when the public FAQs of the tenant last changed as
+// of at: the latest audited change of a FAQ or translation, publish_at or
+// expire_at passed by at, or category update.
+func (s *Store) lastModified(tenant string, at time.Time) time.Time {
+	var out time.Time
+	later := func(t *time.Time) {
+		if t != nil && !t.After(at) && t.After(out) {
+			out = *t
+		}
+	}
+	for _, e := range s.audit {
+		if s.tenants[e.ID] == tenant && e.CreatedAt.After(out) {
+			out = e.CreatedAt
+		}
+	}
+	for _, f := range s.faqs {
+		if s.tenants[f.ID] == tenant {
+			later(f.PublishAt)
+			later(f.ExpireAt)
+		}
+	}
+	for _, c := range s.categories {
+		if s.tenants[c.ID] == tenant && c.UpdatedAt.After(out) {
+			out = c.UpdatedAt
+		}
+	}
+	return out
+}
+
+func (r *FAQRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	if err := validateID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	f, ok := r.s.faq(domain.TenantFromContext(ctx), id)
+	if !ok || f.DeletedAt != nil {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	return cloneFAQ(f), nil
+}
+
+func (r *FAQRepository) Create(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error) {
+	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.createFAQ(ctx, in)
+}
+
+func (r *FAQRepository) Update(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
+	if err := validateID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	return r.s.updateFAQ(ctx, id, in)
+}
+
+// Delete moves a FAQ to the trash. Trashed FAQs are invisible to every
+// other query until restored or purged.
+func (r *FAQRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	_, err := r.s.deleteFAQ(ctx, id)
+	return err
+}
+
+// createFAQ stores a new FAQ of the context's tenant. The caller holds
+// the write lock.
+func (s *Store) createFAQ(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error) {
+	now := s.timestamp()
+	f := domain.FAQ{
+		ID:            uuid.New(),
+		CategoryID:    cloneUUID(in.CategoryID),
+		Title:         in.Title,
+		Content:       in.Content,
+		ContentFormat: in.ContentFormat,
+		Position:      in.Position,
+		IsActive:      in.IsActive,
+		Status:        in.Status,
+		PublishAt:     truncateTime(in.PublishAt),
+		ExpireAt:      truncateTime(in.ExpireAt),
+		Version:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if f.ContentFormat == "" {
+		f.ContentFormat = domain.ContentPlain
+	}
+	if f.Status == domain.StatusPublished {
+		f.PublishedAt = &now
+	}
+	tenant := domain.TenantFromContext(ctx)
+	if err := s.checkFAQ(tenant, f); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	s.faqs[f.ID] = f
+	s.tenants[f.ID] = tenant
+	s.insertRevision(domain.ActorFromContext(ctx), domain.RevisionCreate, f)
+	s.insertAuditEvent(ctx, domain.AuditCreate, nil, &f)
+	return cloneFAQ(f), nil
+}
+
+// updateFAQ replaces the editable fields of a live FAQ. The caller holds
+// the write lock.
+func (s *Store) updateFAQ(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
+	before, err := s.editable(domain.TenantFromContext(ctx), id, in.Version)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	f := cloneFAQ(before)
+	f.CategoryID = cloneUUID(in.CategoryID)
+	f.Title = in.Title
+	f.Content = in.Content
+	if in.ContentFormat != "" {
+		f.ContentFormat = in.ContentFormat
+	}
+	f.Position = in.Position
+	f.IsActive = in.IsActive
+	f.PublishAt = truncateTime(in.PublishAt)
+	f.ExpireAt = truncateTime(in.ExpireAt)
+	return s.saveChange(ctx, domain.RevisionUpdate, domain.AuditUpdate, before, f)
+}
+
+// deleteFAQ moves a live FAQ to the trash and returns it. The caller
+// holds the write lock.
+func (s *Store) deleteFAQ(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	f, ok := s.faq(domain.TenantFromContext(ctx), id)
+	if !ok || f.DeletedAt != nil {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	before := cloneFAQ(f)
+	now := s.timestamp()
+	f.DeletedAt = &now
+	return s.saveChange(ctx, domain.RevisionDelete, domain.AuditDelete, before, f)
+}
+
+// editable returns a live FAQ of the tenant for a versioned update. A
+// non-zero version must match the current one.
+func (s *Store) editable(tenant string, id uuid.UUID, version int) (domain.FAQ, error) {
+	f, ok := s.faq(tenant, id)
+	if !ok || f.DeletedAt != nil {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	if version != 0 && f.Version != version {
+		return domain.FAQ{}, domain.VersionConflictError{Current: f.Version}
+	}
+	return cloneFAQ(f), nil
+}
+
+// save checks and stores a changed FAQ, bumping its version and update
+// time, and records the revision. The FAQ keeps its tenant.
+func (s *Store) save(ctx context.Context, action domain.RevisionAction, f domain.FAQ) (domain.FAQ, error) {
+	if err := s.checkFAQ(s.tenants[f.ID], f); err != nil {
+		return domain.FAQ{}, err
+	}
+	f.Version++
+	f.UpdatedAt = s.timestamp()
+	s.faqs[f.ID] = f
+	s.insertRevision(domain.ActorFromContext(ctx), action, f)
+	return cloneFAQ(f), nil
+}
+
+// saveChange saves f like save and records the change from before in the
+// audit log. Deletions and purges keep no after snapshot.
+func (s *Store) saveChange(ctx context.Context, revision domain.RevisionAction, action domain.AuditAction, before, f domain.FAQ) (domain.FAQ, error) {
+	out, err := s.save(ctx, revision, f)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	after := &out
+	if action == domain.AuditDelete || action == domain.AuditPurge {
+		after = nil
+	}
+	s.insertAuditEvent(ctx, action, &before, after)
+	return out, nil
+}
+
+func truncateTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.Truncate(time.Microsecond)
+	return &v
+}
@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Import applies an import under the write lock. The store is changed in
+// place and put back as it was when a row fails or it is a dry run, the
+// same way the database repositories roll back their transaction.
+func (r *FAQRepository) Import(ctx context.Context, in domain.ImportInput) (domain.ImportResult, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	saved := r.s.saveFAQs()
+
+	live := r.s.liveFAQs(tenant, func(domain.FAQ) bool { return true })
+	current := make(map[uuid.UUID]domain.FAQ, len(live))
+	for _, f := range live {
+		current[f.ID] = f
+	}
+	listed := make(map[uuid.UUID]struct{}, len(in.Items))
+	for _, it := range in.Items {
+		if it.ID != nil {
+			listed[*it.ID] = struct{}{}
+		}
+	}
+
+	var result domain.ImportResult
+	if in.Strategy == domain.ImportReplace {
+		now := r.s.timestamp()
+		for _, f := range live {
+			if _, ok := listed[f.ID]; ok {
+				continue
+			}
+			trashed := cloneFAQ(f)
+			trashed.DeletedAt = &now
+			if _, err := r.s.saveChange(ctx, domain.RevisionDelete, domain.AuditDelete, f, trashed); err != nil {
+				return domain.ImportResult{}, err
+			}
+			before := f
+			result.Changes = append(result.Changes, domain.ImportChange{Action: domain.AuditDelete, Before: &before})
+		}
+	}
+
+	// FAQs changing place are moved out of the way first, so that rows can
+	// swap positions
+	for _, it := range in.Items {
+		if it.ID == nil {
+			continue
+		}
+		if f, ok := current[*it.ID]; ok && (f.Position != it.Position || !sameCategory(f.CategoryID, it.CategoryID)) {
+			moved := r.s.faqs[f.ID]
+			moved.Position = -moved.Position
+			r.s.faqs[f.ID] = moved
+		}
+	}
+
+	for _, it := range in.Items {
+		if it.ID != nil {
+			if f, ok := current[*it.ID]; ok && it.Unchanged(f) {
+				result.Unchanged++
+				continue
+			}
+		}
+		change, err := r.importRow(ctx, tenant, current, it)
+		if err != nil {
+			var ve domain.ValidationError
+			if !errors.As(err, &ve) {
+				return domain.ImportResult{}, err
+			}
+			result.Errors = append(result.Errors, domain.ImportError{Row: it.Row, Message: ve.Message})
+			continue
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	if len(result.Errors) > 0 || in.DryRun {
+		r.s.restoreFAQs(saved)
+	}
+	return result, nil
+}
+
+// importRow updates the live FAQ an item refers to or creates a new one.
+// Rows are checked before they are stored, so a failing row leaves the
+// store untouched.
+func (r *FAQRepository) importRow(ctx context.Context, tenant string, current map[uuid.UUID]domain.FAQ, it domain.ImportItem) (domain.ImportChange, error) {
+	if err := validateFAQInput(it.Title, it.Content, it.Position); err != nil {
+		return domain.ImportChange{}, err
+	}
+
+	if it.ID != nil {
+		if before, ok := current[*it.ID]; ok {
+			f := cloneFAQ(r.s.faqs[before.ID])
+			f.CategoryID = cloneUUID(it.CategoryID)
+			f.Title = it.Title
+			f.Content = it.Content
+			if it.ContentFormat != "" {
+				f.ContentFormat = it.ContentFormat
+			}
+			f.Position = it.Position
+			f.IsActive = it.IsActive
+			f.Status = it.Status
+			f.PublishAt = truncateTime(it.PublishAt)
+			f.ExpireAt = truncateTime(it.ExpireAt)
+			if f.PublishedAt == nil && f.Status == domain.StatusPublished {
+				now := r.s.timestamp()
+				f.PublishedAt = &now
+			}
+			if f.Status == domain.StatusArchived {
+				f.PublishedAt = nil
+			}
+			after, err := r.s.saveChange(ctx, domain.RevisionUpdate, domain.AuditUpdate, before, f)
+			if err != nil {
+				return domain.ImportChange{}, err
+			}
+			return domain.ImportChange{Row: it.Row, Action: domain.AuditUpdate, Before: &before, After: &after}, nil
+		}
+
+		if f, ok := r.s.faqs[*it.ID]; ok {
+			if r.s.tenants[f.ID] == tenant && f.DeletedAt != nil {
+				return domain.ImportChange{}, domain.ValidationError{Message: "faq is in the trash, restore it first"}
+			}
+			return domain.ImportChange{}, domain.ValidationError{Message: "id is already taken"}
+		}
+	}
+
+	now := r.s.timestamp()
+	f := domain.FAQ{
+		ID:            uuid.New(),
+		CategoryID:    cloneUUID(it.CategoryID),
+		Title:         it.Title,
+		Content:       it.Content,
+		ContentFormat: it.ContentFormat,
+		Position:      it.Position,
+		IsActive:      it.IsActive,
+		Status:        it.Status,
+		PublishAt:     truncateTime(it.PublishAt),
+		ExpireAt:      truncateTime(it.ExpireAt),
+		Version:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if it.ID != nil {
+		f.ID = *it.ID
+	}
+	if f.ContentFormat == "" {
+		f.ContentFormat = domain.ContentPlain
+	}
+	if f.Status == domain.StatusPublished {
+		f.PublishedAt = &now
+	}
+	if err := r.s.checkFAQ(tenant, f); err != nil {
+		return domain.ImportChange{}, err
+	}
+
+	r.s.faqs[f.ID] = f
+	r.s.tenants[f.ID] = tenant
+	r.s.insertRevision(domain.ActorFromContext(ctx), domain.RevisionCreate, f)
+	r.s.insertAuditEvent(ctx, domain.AuditCreate, nil, &f)
+	created := cloneFAQ(f)
+	return domain.ImportChange{Row: it.Row, Action: domain.AuditCreate, After: &created}, nil
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// List returns FAQs for the admin list regardless of their status and
+// schedule. Trashed FAQs are excluded. Rows are ordered by the sort key with
+// the ID as a tie-breaker, so the list can be continued from a cursor.
+func (r *FAQRepository) List(ctx context.Context, filter domain.FAQListFilter) ([]domain.FAQ, error) {
+	if !filter.Sort.Valid() {
+		return nil, domain.ValidationError{Message: "sort is invalid"}
+	}
+	var after *domain.FAQ
+	if filter.After != nil {
+		c, err := cursorFAQ(*filter.After)
+		if err != nil {
+			return nil, err
+		}
+		after = &c
+	}
+	query := strings.ToLower(filter.Query)
+
+	r.s.mu.RLock()
+	items := r.s.liveFAQs(domain.TenantFromContext(ctx), func(f domain.FAQ) bool {
+		switch {
+		case filter.IsActive != nil && f.IsActive != *filter.IsActive,
+			filter.Status != "" && f.Status != filter.Status,
+			filter.CategoryID != nil && !sameCategory(f.CategoryID, filter.CategoryID),
+			filter.CreatedFrom != nil && f.CreatedAt.Before(*filter.CreatedFrom),
+			filter.CreatedTo != nil && !f.CreatedAt.Before(*filter.CreatedTo),
+			filter.UpdatedFrom != nil && f.UpdatedAt.Before(*filter.UpdatedFrom),
+			filter.UpdatedTo != nil && !f.UpdatedAt.Before(*filter.UpdatedTo):
+			return false
+		case query != "" && !strings.Contains(strings.ToLower(f.Title), query) &&
+			!strings.Contains(strings.ToLower(f.Content), query):
+			return false
+		}
+		return true
+	})
+	r.s.mu.RUnlock()
+
+	less := func(a, b domain.FAQ) bool {
+		c := compareBy(filter.Sort, a, b)
+		if filter.Desc {
+			return c > 0
+		}
+		return c < 0
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return less(items[i], items[j])
+	})
+
+	out := make([]domain.FAQ, 0, len(items))
+	for _, f := range items {
+		if after != nil && !less(*after, f) {
+			continue
+		}
+		if filter.Limit > 0 && len(out) == filter.Limit {
+			break
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// compareBy compares two FAQs by the sort key and then by ID.
+func compareBy(key domain.FAQSortKey, a, b domain.FAQ) int {
+	var c int
+	switch key {
+	case domain.SortByPosition:
+		c = a.Position - b.Position
+	case domain.SortByCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case domain.SortByUpdatedAt:
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	case domain.SortByTitle:
+		c = strings.Compare(a.Title, b.Title)
+	}
+	if c != 0 {
+		return c
+	}
+	return compareUUID(a.ID, b.ID)
+}
+
+// cursorFAQ turns a cursor into a FAQ carrying just the fields compareBy
+// looks at.
+func cursorFAQ(c domain.FAQCursor) (domain.FAQ, error) {
+	f := domain.FAQ{ID: c.ID}
+	var err error
+	switch c.Sort {
+	case domain.SortByPosition:
+		f.Position, err = strconv.Atoi(c.Value)
+	case domain.SortByCreatedAt:
+		f.CreatedAt, err = time.Parse(time.RFC3339Nano, c.Value)
+	case domain.SortByUpdatedAt:
+		f.UpdatedAt, err = time.Parse(time.RFC3339Nano, c.Value)
+	case domain.SortByTitle:
+		f.Title = c.Value
+	}
+	if err != nil {
+		return domain.FAQ{}, domain.ValidationError{Message: "cursor is invalid"}
+	}
+	return f, nil
+}
@@ -0,0 +1,73 @@
+package memory_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/repository/repotest"
+)
+
+func TestConformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repos {
+		s := memory.NewStore(memory.WithClock(tickingClock()))
+		return repotest.Repos{
+			FAQs:       memory.NewFAQRepository(s),
+			Categories: memory.NewCategoryRepository(s),
+			APIKeys:    memory.NewAPIKeyRepository(s),
+			Audit:      memory.NewAuditRepository(s),
+		}
+	})
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewFAQRepository(memory.NewStore())
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(position int) {
+			defer wg.Done()
+			in := domain.CreateFAQInput{Title: "T", Content: "C", Position: position, IsActive: true, Status: domain.StatusPublished}
+			if _, err := repo.Create(ctx, in); err != nil {
+				t.Errorf("create: %v", err)
+			}
+			// every writer also competes for the same position
+			in.Position = n + 1
+			_, _ = repo.Create(ctx, in)
+		}(i + 1)
+	}
+	wg.Wait()
+
+	active, err := repo.ListActive(ctx, domain.ListActiveFilter{At: time.Now()})
+	if err != nil {
+		t.Fatalf("list active: %v", err)
+	}
+	if len(active.Items) != n+1 {
+		t.Errorf("got %d faqs, want %d", len(active.Items), n+1)
+	}
+}
+
+// tickingClock follows the wall clock but never returns the same instant
+// twice, the way separate transactions never share now() in Postgres.
+func tickingClock() func() time.Time {
+	var (
+		mu   sync.Mutex
+		last time.Time
+	)
+	return func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		now := time.Now()
+		if !now.After(last.Add(time.Microsecond)) {
+			now = last.Add(time.Microsecond)
+		}
+		last = now
+		return now
+	}
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Reorder rewrites the positions of all FAQs in a category to 1..n in the
+// order of in.IDs, which must list every FAQ of the category exactly once.
+func (r *FAQRepository) Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	current := r.s.category(tenant, in.CategoryID)
+	if len(current) != len(in.IDs) {
+		return nil, domain.ValidationError{Message: "ids must list every faq of the category exactly once"}
+	}
+	listed := make(map[uuid.UUID]bool, len(in.IDs))
+	for _, f := range current {
+		listed[f.ID] = true
+	}
+	for _, id := range in.IDs {
+		if !listed[id] {
+			return nil, domain.ValidationError{Message: "ids must list every faq of the category exactly once"}
+		}
+		// a repeated id would otherwise pass the length check
+		delete(listed, id)
+	}
+
+	return r.s.writePositions(ctx, tenant, in.CategoryID, in.IDs), nil
+}
+
+// Move places a FAQ right before or after another FAQ of its category and
+// renumbers the category.
+func (r *FAQRepository) Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error) {
+	if err := validateID(in.ID); err != nil {
+		return nil, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	f, ok := r.s.faq(tenant, in.ID)
+	if !ok || f.DeletedAt != nil {
+		return nil, domain.ErrNotFound
+	}
+	target, ok := r.s.faq(tenant, in.Target)
+	if !ok || target.DeletedAt != nil || !sameCategory(target.CategoryID, f.CategoryID) {
+		return nil, domain.ValidationError{Message: "target faq not found in the same category"}
+	}
+
+	current := r.s.category(tenant, f.CategoryID)
+	ids := make([]uuid.UUID, 0, len(current))
+	for _, c := range current {
+		if c.ID == in.ID {
+			continue
+		}
+		if c.ID == in.Target && !in.After {
+			ids = append(ids, in.ID)
+		}
+		ids = append(ids, c.ID)
+		if c.ID == in.Target && in.After {
+			ids = append(ids, in.ID)
+		}
+	}
+
+	return r.s.writePositions(ctx, tenant, f.CategoryID, ids), nil
+}
+
+// category returns the live FAQs of a category of the tenant in their
+// current order.
+func (s *Store) category(tenant string, categoryID *uuid.UUID) []domain.FAQ {
+	return s.liveFAQs(tenant, func(f domain.FAQ) bool {
+		return sameCategory(f.CategoryID, categoryID)
+	})
+}
+
+// writePositions assigns position i+1 to ids[i], writing a revision and an
+// audit event for every FAQ that actually moved, and returns the category
+// in its new order.
+func (s *Store) writePositions(ctx context.Context, tenant string, categoryID *uuid.UUID, ids []uuid.UUID) []domain.FAQ {
+	actor := domain.ActorFromContext(ctx)
+	now := s.timestamp()
+	for i, id := range ids {
+		before := s.faqs[id]
+		if before.Position == i+1 {
+			continue
+		}
+		f := before
+		f.Position = i + 1
+		f.Version++
+		f.UpdatedAt = now
+		s.faqs[id] = f
+		s.insertRevision(actor, domain.RevisionUpdate, f)
+		s.insertAuditEvent(ctx, domain.AuditUpdate, &before, &f)
+	}
+	return s.category(tenant, categoryID)
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Patch changes only the fields set in the patch.
+func (r *FAQRepository) Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error) {
+	if err := validateID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	before, err := r.s.editable(domain.TenantFromContext(ctx), id, patch.Version)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	f := cloneFAQ(before)
+	if patch.CategoryID.Set {
+		f.CategoryID = cloneUUID(patch.CategoryID.Value)
+	}
+	if patch.Title.Set {
+		f.Title = patch.Title.Value
+	}
+	if patch.Content.Set {
+		f.Content = patch.Content.Value
+	}
+	if patch.ContentFormat.Set {
+		f.ContentFormat = patch.ContentFormat.Value
+	}
+	if patch.Position.Set {
+		f.Position = patch.Position.Value
+	}
+	if patch.IsActive.Set {
+		f.IsActive = patch.IsActive.Value
+	}
+	if patch.PublishAt.Set {
+		f.PublishAt = truncateTime(patch.PublishAt.Value)
+	}
+	if patch.ExpireAt.Set {
+		f.ExpireAt = truncateTime(patch.ExpireAt.Value)
+	}
+	return r.s.saveChange(ctx, domain.RevisionUpdate, domain.AuditUpdate, before, f)
+}
@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (r *FAQRepository) ListRevisions(ctx context.Context, faqID uuid.UUID) ([]domain.Revision, error) {
+	if err := validateID(faqID); err != nil {
+		return nil, err
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	revs := r.s.history(domain.TenantFromContext(ctx), faqID)
+	out := make([]domain.Revision, 0, len(revs))
+	for i := len(revs) - 1; i >= 0; i-- {
+		out = append(out, cloneRevision(revs[i]))
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) GetRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.Revision, error) {
+	if err := validateID(faqID); err != nil {
+		return domain.Revision{}, err
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	revs := r.s.history(domain.TenantFromContext(ctx), faqID)
+	if number < 1 || number > len(revs) {
+		return domain.Revision{}, domain.ErrNotFound
+	}
+	return cloneRevision(revs[number-1]), nil
+}
+
+// RestoreRevision makes the snapshot of a revision the current version of
+// the FAQ, taking it out of the trash or recreating it if it has been
+// purged since.
+func (r *FAQRepository) RestoreRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.FAQ, error) {
+	if err := validateID(faqID); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	revs := r.s.history(tenant, faqID)
+	if number < 1 || number > len(revs) {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	snap := cloneSnapshot(revs[number-1].Snapshot)
+
+	// revisions written before the workflow existed have no status
+	if !snap.Status.Valid() {
+		snap.Status = domain.StatusPublished
+	}
+	// nor a content format before formats existed
+	if !snap.ContentFormat.Valid() {
+		snap.ContentFormat = domain.ContentPlain
+	}
+
+	before, exists := r.s.faqs[faqID]
+	f := domain.FAQ{ID: faqID, CreatedAt: snap.CreatedAt}
+	if exists {
+		before = cloneFAQ(before)
+		f = cloneFAQ(before)
+	}
+	f.CategoryID = snap.CategoryID
+	f.Title = snap.Title
+	f.Content = snap.Content
+	f.ContentFormat = snap.ContentFormat
+	f.Position = snap.Position
+	f.IsActive = snap.IsActive
+	f.Status = snap.Status
+	f.Draft = snap.Draft
+	f.PublishAt = snap.PublishAt
+	f.ExpireAt = snap.ExpireAt
+	f.DeletedAt = nil
+	if f.PublishedAt == nil && f.Status == domain.StatusPublished {
+		now := r.s.timestamp()
+		f.PublishedAt = &now
+	}
+	if f.Status == domain.StatusArchived {
+		f.PublishedAt = nil
+	}
+
+	if !exists {
+		// a recreated FAQ starts over like a new row
+		if err := r.s.checkFAQ(tenant, f); err != nil {
+			return domain.FAQ{}, err
+		}
+		now := r.s.timestamp()
+		f.Version = 1
+		f.UpdatedAt = now
+		r.s.faqs[f.ID] = f
+		r.s.insertRevision(domain.ActorFromContext(ctx), domain.RevisionRestore, f)
+		r.s.insertAuditEvent(ctx, domain.AuditRestore, nil, &f)
+		return cloneFAQ(f), nil
+	}
+	// a live FAQ only changes, one from the trash comes back
+	action := domain.AuditUpdate
+	if before.DeletedAt != nil {
+		action = domain.AuditRestore
+	}
+	return r.s.saveChange(ctx, domain.RevisionRestore, action, before, f)
+}
+
+// history returns the revisions of a FAQ of the tenant, oldest first.
+func (s *Store) history(tenant string, faqID uuid.UUID) []domain.Revision {
+	if s.tenants[faqID] != tenant {
+		return nil
+	}
+	return s.revisions[faqID]
+}
+
+func cloneRevision(rev domain.Revision) domain.Revision {
+	rev.Snapshot = cloneSnapshot(rev.Snapshot)
+	return rev
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/textmatch"
+)
+
+// Search matches FAQs word by word with textmatch instead of a text
+// search engine, so the language of the query is ignored.
+func (r *FAQRepository) Search(ctx context.Context, in domain.SearchQuery) ([]domain.SearchResult, error) {
+	query := textmatch.Parse(in.Query)
+	if query.Empty() {
+		return []domain.SearchResult{}, nil
+	}
+
+	r.s.mu.RLock()
+	items := r.s.liveFAQs(domain.TenantFromContext(ctx), func(f domain.FAQ) bool {
+		return isPublic(f, in.At) && (in.CategoryID == nil || sameCategory(f.CategoryID, in.CategoryID))
+	})
+	r.s.mu.RUnlock()
+
+	out := make([]domain.SearchResult, 0)
+	for _, f := range items {
+		rank, ok := query.Rank(f.Title, f.Content)
+		if !ok {
+			continue
+		}
+		out = append(out, domain.SearchResult{
+			FAQ:              f,
+			Rank:             rank,
+			TitleHighlight:   query.Highlight(f.Title),
+			ContentHighlight: query.Highlight(f.Content),
+		})
+	}
+
+	// items are already ordered by position, which breaks rank ties
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Rank > out[j].Rank
+	})
+	if in.Limit > 0 && len(out) > in.Limit {
+		out = out[:in.Limit]
+	}
+	return out, nil
+}
@@ -0,0 +1,260 @@
+// Package memory keeps FAQs and categories in process memory. It mirrors
+// the semantics of the Postgres repositories and is meant for local runs
+// and tests; nothing survives a restart.
+package memory
+
+import (
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Store holds the data shared by the FAQ and category repositories. A
+// single lock guards all of it, so every repository call is atomic the
+// same way a database transaction is.
+type Store struct {
+	mu           sync.RWMutex
+	faqs         map[uuid.UUID]domain.FAQ
+	categories   map[uuid.UUID]domain.Category
+	translations map[uuid.UUID]map[string]domain.Translation
+	revisions    map[uuid.UUID][]domain.Revision
+	apiKeys      map[uuid.UUID]domain.APIKey
+	audit        []domain.AuditEvent
+	// tenants maps the IDs of FAQs, categories and audit events to the
+	// tenant they belong to. FAQ entries survive a purge, like revisions.
+	tenants map[uuid.UUID]string
+	now     func() time.Time
+}
+
+type StoreOption func(*Store)
+
+// WithClock sets the source of timestamps written by the store.
+func WithClock(now func() time.Time) StoreOption {
+	return func(s *Store) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+func NewStore(opts ...StoreOption) *Store {
+	s := &Store{
+		faqs:         make(map[uuid.UUID]domain.FAQ),
+		categories:   make(map[uuid.UUID]domain.Category),
+		translations: make(map[uuid.UUID]map[string]domain.Translation),
+		revisions:    make(map[uuid.UUID][]domain.Revision),
+		apiKeys:      make(map[uuid.UUID]domain.APIKey),
+		tenants:      make(map[uuid.UUID]string),
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// timestamp returns the current time with the microsecond precision
+// Postgres stores timestamps with.
+func (s *Store) timestamp() time.Time {
+	return s.now().UTC().Truncate(time.Microsecond)
+}
+
+// faq returns a stored FAQ of the tenant, trashed or not.
+func (s *Store) faq(tenant string, id uuid.UUID) (domain.FAQ, bool) {
+	f, ok := s.faqs[id]
+	if !ok || s.tenants[id] != tenant {
+		return domain.FAQ{}, false
+	}
+	return f, true
+}
+
+// positionTaken reports whether a live FAQ of the tenant other than except
+// occupies the position in the category.
+func (s *Store) positionTaken(tenant string, categoryID *uuid.UUID, position int, except uuid.UUID) bool {
+	for _, f := range s.faqs {
+		if f.ID != except && f.DeletedAt == nil && s.tenants[f.ID] == tenant &&
+			sameCategory(f.CategoryID, categoryID) && f.Position == position {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFAQ enforces the constraints the faqs table has in Postgres.
+func (s *Store) checkFAQ(tenant string, f domain.FAQ) error {
+	if f.CategoryID != nil {
+		if _, ok := s.categories[*f.CategoryID]; !ok || s.tenants[*f.CategoryID] != tenant {
+			return domain.ValidationError{Message: "category not found"}
+		}
+	}
+	if !f.ContentFormat.Valid() {
+		return domain.ValidationError{Message: "content_format must be plain, markdown or html"}
+	}
+	if f.PublishAt != nil && f.ExpireAt != nil && !f.ExpireAt.After(*f.PublishAt) {
+		return domain.ValidationError{Message: "expire_at must be after publish_at"}
+	}
+	if f.DeletedAt == nil && s.positionTaken(tenant, f.CategoryID, f.Position, f.ID) {
+		return domain.ValidationError{Message: "position is already taken in the category"}
+	}
+	return nil
+}
+
+// liveFAQs returns copies of the FAQs of the tenant outside the trash
+// matching keep, ordered by position.
+func (s *Store) liveFAQs(tenant string, keep func(domain.FAQ) bool) []domain.FAQ {
+	out := make([]domain.FAQ, 0)
+	for _, f := range s.faqs {
+		if f.DeletedAt == nil && s.tenants[f.ID] == tenant && keep(f) {
+			out = append(out, cloneFAQ(f))
+		}
+	}
+	sortByPosition(out)
+	return out
+}
+
+// insertRevision appends the next revision of a FAQ. It must be called
+// with the write lock held, after the FAQ has been stored.
+func (s *Store) insertRevision(actor string, action domain.RevisionAction, faq domain.FAQ) {
+	revs := s.revisions[faq.ID]
+	s.revisions[faq.ID] = append(revs, domain.Revision{
+		FAQID:     faq.ID,
+		Number:    len(revs) + 1,
+		Action:    action,
+		Actor:     actor,
+		Snapshot:  cloneSnapshot(domain.NewFAQSnapshot(faq)),
+		CreatedAt: s.timestamp(),
+	})
+}
+
+// isPublic mirrors faqPublicCondition of the Postgres repository.
+// faqState is a copy of the FAQ data of the store, taken before a
+// multi-step write that must be undone as a whole when a step fails.
+type faqState struct {
+	faqs      map[uuid.UUID]domain.FAQ
+	revisions map[uuid.UUID][]domain.Revision
+	audit     []domain.AuditEvent
+	tenants   map[uuid.UUID]string
+}
+
+// saveFAQs copies the FAQ data. Stored values are never changed in place
+// and the audit log is only appended to, so shallow copies are enough.
+func (s *Store) saveFAQs() faqState {
+	return faqState{faqs: maps.Clone(s.faqs), revisions: maps.Clone(s.revisions), audit: s.audit,
+		tenants: maps.Clone(s.tenants)}
+}
+
+func (s *Store) restoreFAQs(state faqState) {
+	s.faqs, s.revisions, s.audit, s.tenants = state.faqs, state.revisions, state.audit, state.tenants
+}
+
+func isPublic(f domain.FAQ, at time.Time) bool {
+	return f.DeletedAt == nil && f.IsActive && f.PublishedAt != nil &&
+		f.Status != domain.StatusArchived && f.Scheduled(at)
+}
+
+func sameCategory(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// sortByPosition orders FAQs by position, falling back to the creation
+// time and the ID so the order is stable across calls.
+func sortByPosition(items []domain.FAQ) {
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return compareUUID(a.ID, b.ID) < 0
+	})
+}
+
+// compareUUID orders IDs the way Postgres compares uuid values.
+func compareUUID(a, b uuid.UUID) int {
+	return strings.Compare(string(a[:]), string(b[:]))
+}
+
+// The store hands out copies, so callers cannot change stored values
+// through pointer fields.
+
+func cloneFAQ(f domain.FAQ) domain.FAQ {
+	f.CategoryID = cloneUUID(f.CategoryID)
+	f.PublishedAt = cloneTime(f.PublishedAt)
+	f.PublishAt = cloneTime(f.PublishAt)
+	f.ExpireAt = cloneTime(f.ExpireAt)
+	f.DeletedAt = cloneTime(f.DeletedAt)
+	if f.Draft != nil {
+		draft := *f.Draft
+		f.Draft = &draft
+	}
+	return f
+}
+
+func cloneSnapshot(s domain.FAQSnapshot) domain.FAQSnapshot {
+	s.CategoryID = cloneUUID(s.CategoryID)
+	s.PublishAt = cloneTime(s.PublishAt)
+	s.ExpireAt = cloneTime(s.ExpireAt)
+	if s.Draft != nil {
+		draft := *s.Draft
+		s.Draft = &draft
+	}
+	return s
+}
+
+func cloneCategory(c domain.Category) domain.Category {
+	c.ParentID = cloneUUID(c.ParentID)
+	return c
+}
+
+func cloneUUID(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	v := *id
+	return &v
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
+
+func validateID(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	return nil
+}
+
+func validateFAQInput(title, content string, position int) error {
+	if err := validateContent(title, content); err != nil {
+		return err
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
+
+func validateContent(title, content string) error {
+	if strings.TrimSpace(title) == "" {
+		return domain.ValidationError{Message: "title is required"}
+	}
+	if strings.TrimSpace(content) == "" {
+		return domain.ValidationError{Message: "content is required"}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (r *FAQRepository) ListTranslations(ctx context.Context, faqID uuid.UUID) ([]domain.Translation, error) {
+	if err := validateID(faqID); err != nil {
+		return nil, err
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	translations := r.s.faqTranslations(domain.TenantFromContext(ctx), faqID)
+	out := make([]domain.Translation, 0, len(translations))
+	for _, t := range translations {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Locale < out[j].Locale
+	})
+	return out, nil
+}
+
+func (r *FAQRepository) FindTranslations(ctx context.Context, faqIDs []uuid.UUID, locales []string) ([]domain.Translation, error) {
+	out := make([]domain.Translation, 0)
+	if len(faqIDs) == 0 || len(locales) == 0 {
+		return out, nil
+	}
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	seen := make(map[uuid.UUID]bool, len(faqIDs))
+	for _, id := range faqIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		translations := r.s.faqTranslations(tenant, id)
+		for _, locale := range locales {
+			if t, ok := translations[locale]; ok {
+				out = append(out, t)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) UpsertTranslation(ctx context.Context, in domain.UpsertTranslationInput) (domain.Translation, error) {
+	if err := validateID(in.FAQID); err != nil {
+		return domain.Translation{}, err
+	}
+	if _, ok := domain.NormalizeLocale(in.Locale); !ok {
+		return domain.Translation{}, domain.ValidationError{Message: "locale is invalid"}
+	}
+	if err := validateContent(in.Title, in.Content); err != nil {
+		return domain.Translation{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	f, ok := r.s.faq(domain.TenantFromContext(ctx), in.FAQID)
+	if !ok || f.DeletedAt != nil {
+		return domain.Translation{}, domain.ErrNotFound
+	}
+
+	now := r.s.timestamp()
+	before, exists := r.s.translations[in.FAQID][in.Locale]
+	t := before
+	if !exists {
+		t = domain.Translation{FAQID: in.FAQID, Locale: in.Locale, CreatedAt: now}
+	}
+	t.Title = in.Title
+	t.Content = in.Content
+	t.UpdatedAt = now
+
+	if r.s.translations[in.FAQID] == nil {
+		r.s.translations[in.FAQID] = make(map[string]domain.Translation)
+	}
+	r.s.translations[in.FAQID][in.Locale] = t
+	if exists {
+		r.s.appendAudit(ctx, domain.NewTranslationAuditEventInput(ctx, domain.AuditUpdate, f, in.Locale, &before, &t))
+	} else {
+		r.s.appendAudit(ctx, domain.NewTranslationAuditEventInput(ctx, domain.AuditCreate, f, in.Locale, nil, &t))
+	}
+	return t, nil
+}
+
+func (r *FAQRepository) DeleteTranslation(ctx context.Context, faqID uuid.UUID, locale string) error {
+	if err := validateID(faqID); err != nil {
+		return err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	before, ok := r.s.faqTranslations(domain.TenantFromContext(ctx), faqID)[locale]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	delete(r.s.translations[faqID], locale)
+	if len(r.s.translations[faqID]) == 0 {
+		delete(r.s.translations, faqID)
+	}
+	r.s.appendAudit(ctx, domain.NewTranslationAuditEventInput(ctx, domain.AuditDelete, r.s.faqs[faqID], locale, &before, nil))
+	return nil
+}
+
+func (r *FAQRepository) ListMissingTranslations(ctx context.Context, locale string) ([]domain.FAQ, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.liveFAQs(domain.TenantFromContext(ctx), func(f domain.FAQ) bool {
+		_, ok := r.s.translations[f.ID][locale]
+		return !ok
+	}), nil
+}
+
+// faqTranslations returns the translations of a FAQ of the tenant by
+// locale. Translations have no tenant of their own.
+func (s *Store) faqTranslations(tenant string, faqID uuid.UUID) map[string]domain.Translation {
+	if _, ok := s.faq(tenant, faqID); !ok {
+		return nil
+	}
+	return s.translations[faqID]
+}
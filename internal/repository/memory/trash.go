@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (r *FAQRepository) ListDeleted(ctx context.Context) ([]domain.FAQ, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	out := make([]domain.FAQ, 0)
+	for _, f := range r.s.faqs {
+		if f.DeletedAt != nil && r.s.tenants[f.ID] == tenant {
+			out = append(out, cloneFAQ(f))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].DeletedAt.Equal(*out[j].DeletedAt) {
+			return out[i].DeletedAt.After(*out[j].DeletedAt)
+		}
+		return compareUUID(out[i].ID, out[j].ID) < 0
+	})
+	return out, nil
+}
+
+// Restore takes a FAQ out of the trash. FAQs that are not in the trash
+// are reported as not found. If its position has been taken meanwhile,
+// the FAQ is appended to the end of its category.
+func (r *FAQRepository) Restore(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	if err := validateID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	before, ok := r.s.faq(tenant, id)
+	if !ok || before.DeletedAt == nil {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	f := cloneFAQ(before)
+	f.DeletedAt = nil
+	if r.s.positionTaken(tenant, f.CategoryID, f.Position, f.ID) {
+		f.Position = r.s.lastPosition(tenant, f.CategoryID) + 1
+	}
+	return r.s.saveChange(ctx, domain.RevisionRestore, domain.AuditRestore, cloneFAQ(before), f)
+}
+
+// Purge permanently removes a FAQ from the trash together with its
+// translations. Revisions are kept.
+func (r *FAQRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	f, ok := r.s.faq(domain.TenantFromContext(ctx), id)
+	if !ok || f.DeletedAt == nil {
+		return domain.ErrNotFound
+	}
+	r.s.purge(ctx, f)
+	return nil
+}
+
+// PurgeDeleted permanently removes FAQs moved to the trash before the
+// given moment and returns how many were removed. domain.AnyTenant in the
+// context purges the trash of every tenant.
+func (r *FAQRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	tenant := domain.TenantFromContext(ctx)
+	purged := 0
+	for id, f := range r.s.faqs {
+		if tenant != domain.AnyTenant && r.s.tenants[id] != tenant {
+			continue
+		}
+		if f.DeletedAt != nil && f.DeletedAt.Before(before) {
+			r.s.purge(domain.WithTenant(ctx, r.s.tenants[id]), f)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// purge removes a FAQ of the context's tenant and its translations and
+// records it in the audit log. Its tenant stays known for the revisions.
+func (s *Store) purge(ctx context.Context, f domain.FAQ) {
+	delete(s.faqs, f.ID)
+	delete(s.translations, f.ID)
+	s.insertAuditEvent(ctx, domain.AuditPurge, &f, nil)
+}
+
+// lastPosition returns the highest position among the live FAQs of a
+// category of the tenant, or 0 when it is empty.
+func (s *Store) lastPosition(tenant string, categoryID *uuid.UUID) int {
+	last := 0
+	for _, f := range s.faqs {
+		if f.DeletedAt == nil && s.tenants[f.ID] == tenant && sameCategory(f.CategoryID, categoryID) && f.Position > last {
+			last = f.Position
+		}
+	}
+	return last
+}
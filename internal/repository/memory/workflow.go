@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// SaveDraft stores pending content and moves the FAQ back to draft.
+// Archived FAQs are left untouched and reported as not found.
+func (r *FAQRepository) SaveDraft(ctx context.Context, id uuid.UUID, draft domain.FAQDraft) (domain.FAQ, error) {
+	if err := validateID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := validateContent(draft.Title, draft.Content); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	before, err := r.s.editable(domain.TenantFromContext(ctx), id, 0)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if before.Status == domain.StatusArchived {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	f := cloneFAQ(before)
+	f.Draft = &draft
+	f.Status = domain.StatusDraft
+	return r.s.saveChange(ctx, domain.RevisionUpdate, domain.AuditUpdate, before, f)
+}
+
+// ChangeStatus moves a FAQ from one status to another. The update only
+// applies while the FAQ is still in status from, otherwise a validation
+// error is returned. Publishing swaps the draft into the live content.
+func (r *FAQRepository) ChangeStatus(ctx context.Context, id uuid.UUID, from, to domain.FAQStatus) (domain.FAQ, error) {
+	if err := validateID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	before, err := r.s.editable(domain.TenantFromContext(ctx), id, 0)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if before.Status != from {
+		return domain.FAQ{}, domain.ValidationError{Message: "status has been changed concurrently"}
+	}
+	f := cloneFAQ(before)
+	f.Status = to
+	if to == domain.StatusPublished {
+		if f.Draft != nil {
+			f.Title = f.Draft.Title
+			f.Content = f.Draft.Content
+			f.Draft = nil
+		}
+		now := r.s.timestamp()
+		f.PublishedAt = &now
+	}
+	if to == domain.StatusArchived {
+		f.PublishedAt = nil
+	}
+	return r.s.saveChange(ctx, domain.RevisionUpdate, domain.AuditUpdate, before, f)
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Reorder rewrites the positions of all FAQs in a category to 1..n in the
+// order of in.IDs, which must list every FAQ of the category exactly once.
+func (r *FAQRepository) Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, positions, err := lockCategory(ctx, tx, in.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) != len(in.IDs) {
+		return nil, domain.ValidationError{Message: "ids must list every faq of the category exactly once"}
+	}
+	for _, id := range in.IDs {
+		if _, ok := positions[id]; !ok {
+			return nil, domain.ValidationError{Message: "ids must list every faq of the category exactly once"}
+		}
+	}
+
+	out, err := writePositions(ctx, tx, in.CategoryID, positions, in.IDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// Move places a FAQ right before or after another FAQ of its category and
+// renumbers the category.
+func (r *FAQRepository) Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error) {
+	if err := validateFAQID(in.ID); err != nil {
+		return nil, err
+	}
+	const categoryQ = `SELECT category_id FROM faqs WHERE id = $1 AND tenant = $2 AND deleted_at IS NULL`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var categoryID uuid.NullUUID
+	if err := tx.QueryRowContext(ctx, categoryQ, in.ID.String(), domain.TenantFromContext(ctx)).Scan(&categoryID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get faq category: %w", err)
+	}
+
+	order, positions, err := lockCategory(ctx, tx, uuidPtr(categoryID))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := positions[in.ID]; !ok {
+		return nil, domain.ValidationError{Message: "category has been changed concurrently"}
+	}
+	if _, ok := positions[in.Target]; !ok {
+		return nil, domain.ValidationError{Message: "target faq not found in the same category"}
+	}
+
+	ids := make([]uuid.UUID, 0, len(order))
+	for _, id := range order {
+		if id == in.ID {
+			continue
+		}
+		if id == in.Target && !in.After {
+			ids = append(ids, in.ID)
+		}
+		ids = append(ids, id)
+		if id == in.Target && in.After {
+			ids = append(ids, in.ID)
+		}
+	}
+
+	out, err := writePositions(ctx, tx, uuidPtr(categoryID), positions, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// lockCategory locks the live FAQs of a category and returns their IDs in
+// the current order together with their positions.
+func lockCategory(ctx context.Context, tx *sql.Tx, categoryID *uuid.UUID) ([]uuid.UUID, map[uuid.UUID]int, error) {
+	const q = `
+		SELECT id, position
+		FROM faqs
+		WHERE tenant = $2 AND deleted_at IS NULL AND category_id IS NOT DISTINCT FROM $1::uuid
+		ORDER BY position ASC, created_at ASC
+		FOR UPDATE
+	`
+
+	rows, err := tx.QueryContext(ctx, q, nullUUID(categoryID), domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, nil, fmt.Errorf("lock category: %w", err)
+	}
+	defer rows.Close()
+
+	order := make([]uuid.UUID, 0)
+	positions := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			idRaw    string
+			position int
+		)
+		if err := rows.Scan(&idRaw, &position); err != nil {
+			return nil, nil, fmt.Errorf("scan position: %w", err)
+		}
+		id, err := uuid.Parse(idRaw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse faq id: %w", err)
+		}
+		order = append(order, id)
+		positions[id] = position
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("iterate positions: %w", err)
+	}
+	return order, positions, nil
+}
+
+// writePositions assigns position i+1 to ids[i], writing a revision and an
+// audit event for every FAQ that actually moved, and returns the category
+// in its new order.
+// Moved rows are first flipped to negative positions so that the unique
+// (tenant, category_id, position) index is never violated halfway through.
+// ids are all locked by lockCategory, so they belong to the tenant.
+func writePositions(ctx context.Context, tx *sql.Tx, categoryID *uuid.UUID, current map[uuid.UUID]int, ids []uuid.UUID) ([]domain.FAQ, error) {
+	const (
+		beforeQ = `SELECT ` + faqColumns + ` FROM faqs WHERE id = ANY($1::uuid[])`
+		flipQ   = `UPDATE faqs SET position = -position WHERE id = ANY($1::uuid[])`
+		setQ    = `
+			UPDATE faqs f
+			SET position = o.pos, version = version + 1, updated_at = now()
+			FROM unnest($1::uuid[], $2::int[]) AS o(faq_id, pos)
+			WHERE f.id = o.faq_id
+			RETURNING ` + faqColumns
+		listQ = `
+			SELECT ` + faqColumns + `
+			FROM faqs
+			WHERE tenant = $2 AND deleted_at IS NULL AND category_id IS NOT DISTINCT FROM $1::uuid
+			ORDER BY position ASC
+		`
+	)
+
+	moved := make([]string, 0)
+	targets := make([]int64, 0)
+	for i, id := range ids {
+		if current[id] != i+1 {
+			moved = append(moved, id.String())
+			targets = append(targets, int64(i+1))
+		}
+	}
+
+	if len(moved) > 0 {
+		befores, err := collectFAQs(tx.QueryContext(ctx, beforeQ, pq.Array(moved)))
+		if err != nil {
+			return nil, fmt.Errorf("reorder faqs: %w", err)
+		}
+		before := make(map[uuid.UUID]domain.FAQ, len(befores))
+		for _, faq := range befores {
+			before[faq.ID] = faq
+		}
+		if _, err := tx.ExecContext(ctx, flipQ, pq.Array(moved)); err != nil {
+			return nil, fmt.Errorf("reorder faqs: %w", err)
+		}
+		changed, err := collectFAQs(tx.QueryContext(ctx, setQ, pq.Array(moved), pq.Array(targets)))
+		if err != nil {
+			return nil, fmt.Errorf("reorder faqs: %w", err)
+		}
+		for _, faq := range changed {
+			if err := insertRevision(ctx, tx, domain.RevisionUpdate, faq); err != nil {
+				return nil, err
+			}
+			prev := before[faq.ID]
+			if err := insertAuditEvent(ctx, tx, domain.AuditUpdate, &prev, &faq); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	out, err := collectFAQs(tx.QueryContext(ctx, listQ, nullUUID(categoryID), domain.TenantFromContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("list category: %w", err)
+	}
+	return out, nil
+}
+
+// collectFAQs reads all rows of a faqColumns query and closes them, so the
+// transaction can be used again afterwards.
+func collectFAQs(rows *sql.Rows, err error) ([]domain.FAQ, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]domain.FAQ, 0)
+	for rows.Next() {
+		faq, err := scanFAQ(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, faq)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate faqs: %w", err)
+	}
+	return out, nil
+}
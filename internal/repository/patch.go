@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Patch changes only the fields set in the patch.
+func (r *FAQRepository) Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	const q = `
+		UPDATE faqs
+		SET category_id = CASE WHEN $2 THEN $3::uuid ELSE category_id END,
+			title = CASE WHEN $4 THEN $5 ELSE title END,
+			content = CASE WHEN $6 THEN $7 ELSE content END,
+			content_format = CASE WHEN $18 THEN $19 ELSE content_format END,
+			position = CASE WHEN $8 THEN $9::int ELSE position END,
+			is_active = CASE WHEN $10 THEN $11::boolean ELSE is_active END,
+			publish_at = CASE WHEN $12 THEN $13::timestamptz ELSE publish_at END,
+			expire_at = CASE WHEN $14 THEN $15::timestamptz ELSE expire_at END,
+			version = version + 1,
+			updated_at = now()
+		WHERE id = $1 AND tenant = $17 AND deleted_at IS NULL AND ($16 = 0 OR version = $16)
+		RETURNING ` + faqColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(),
+		patch.CategoryID.Set, nullUUID(patch.CategoryID.Value),
+		patch.Title.Set, patch.Title.Value,
+		patch.Content.Set, patch.Content.Value,
+		patch.Position.Set, patch.Position.Value,
+		patch.IsActive.Set, patch.IsActive.Value,
+		patch.PublishAt.Set, patch.PublishAt.Value,
+		patch.ExpireAt.Set, patch.ExpireAt.Value,
+		patch.Version,
+		domain.TenantFromContext(ctx),
+		patch.ContentFormat.Set, string(patch.ContentFormat.Value),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, notUpdated(ctx, tx, id)
+		}
+		return domain.FAQ{}, fmt.Errorf("patch faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionUpdate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, domain.AuditUpdate, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/nightmaker00/accordion-go/internal/repository"
+	"github.com/nightmaker00/accordion-go/internal/repository/repotest"
+	"github.com/nightmaker00/accordion-go/migrations"
+	"github.com/nightmaker00/accordion-go/pkg/db/migrate"
+)
+
+// TestConformance runs against the database given by TEST_POSTGRES_DSN,
+// migrating it first. Every test starts by emptying its tables.
+func TestConformance(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	migrator, err := migrate.New(db, migrate.Postgres, migrations.Postgres)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	repotest.Run(t, func(t *testing.T) repotest.Repos {
+		const q = `TRUNCATE faq_revisions, faq_translations, faqs, categories, api_keys, audit_events`
+		if _, err := db.Exec(q); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		return repotest.Repos{
+			FAQs:       repository.NewFAQRepository(db),
+			Categories: repository.NewCategoryRepository(db),
+			APIKeys:    repository.NewAPIKeyRepository(db),
+			Audit:      repository.NewAuditRepository(db),
+		}
+	})
+}
@@ -0,0 +1,1323 @@
+// Package repotest is a conformance suite for storage backends. Every
+// implementation of the service repository interfaces runs it, so that
+// the service behaves the same whichever one is selected.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+// Repos are the repositories of one storage backend.
+type Repos struct {
+	FAQs       service.FAQRepository
+	Categories service.CategoryRepository
+	APIKeys    service.APIKeyRepository
+	Audit      service.AuditRepository
+}
+
+// Factory returns repositories over empty storage. It is called once per
+// test.
+type Factory func(t *testing.T) Repos
+
+// Run runs the conformance suite against the repositories made by newRepos.
+func Run(t *testing.T, newRepos Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r Repos)
+	}{
+		{"CreateAndGet", testCreateAndGet},
+		{"NotFound", testNotFound},
+		{"ListActive", testListActive},
+		{"LastModified", testLastModified},
+		{"PositionUnique", testPositionUnique},
+		{"Update", testUpdate},
+		{"Patch", testPatch},
+		{"ContentFormat", testContentFormat},
+		{"List", testList},
+		{"Search", testSearch},
+		{"Translations", testTranslations},
+		{"Revisions", testRevisions},
+		{"ConcurrentRestore", testConcurrentRestore},
+		{"Workflow", testWorkflow},
+		{"Trash", testTrash},
+		{"Order", testOrder},
+		{"Categories", testCategories},
+		{"APIKeys", testAPIKeys},
+		{"Audit", testAudit},
+		{"Tenants", testTenants},
+		{"Import", testImport},
+		{"Batch", testBatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepos(t))
+		})
+	}
+}
+
+func testCreateAndGet(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	before := time.Now().Add(-time.Second)
+
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Shipping", Content: "Two days", Position: 1})
+	if created.ID == uuid.Nil {
+		t.Fatal("created faq has no id")
+	}
+	if created.Version != 1 {
+		t.Errorf("version = %d, want 1", created.Version)
+	}
+	if created.CreatedAt.Before(before) || !created.UpdatedAt.Equal(created.CreatedAt) {
+		t.Errorf("timestamps = %v / %v, want both set to the creation time", created.CreatedAt, created.UpdatedAt)
+	}
+	if created.PublishedAt == nil {
+		t.Error("published faq has no published_at")
+	}
+
+	got, err := faqs.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Title != "Shipping" || got.Content != "Two days" || got.Position != 1 || !got.IsActive {
+		t.Errorf("got %+v", got)
+	}
+	if !got.CreatedAt.Equal(created.CreatedAt) || got.Version != created.Version {
+		t.Errorf("get returned %v v%d, create returned %v v%d", got.CreatedAt, got.Version, created.CreatedAt, created.Version)
+	}
+
+	draft := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Draft", Content: "Soon", Position: 2, Status: domain.StatusDraft})
+	if draft.PublishedAt != nil {
+		t.Error("draft faq has published_at")
+	}
+}
+
+func testNotFound(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	missing := uuid.New()
+
+	if _, err := faqs.GetByID(ctx, missing); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get: err = %v, want ErrNotFound", err)
+	}
+	if _, err := faqs.GetByID(ctx, uuid.Nil); !isValidation(err) {
+		t.Errorf("get nil id: err = %v, want validation error", err)
+	}
+	if _, err := faqs.Update(ctx, missing, domain.UpdateFAQInput{Title: "T", Content: "C", Position: 1}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("update: err = %v, want ErrNotFound", err)
+	}
+	if err := faqs.Delete(ctx, missing); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("delete: err = %v, want ErrNotFound", err)
+	}
+	if _, err := categories.GetByID(ctx, missing); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get category: err = %v, want ErrNotFound", err)
+	}
+	if err := categories.Delete(ctx, missing); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("delete category: err = %v, want ErrNotFound", err)
+	}
+
+	_, err := faqs.Create(ctx, domain.CreateFAQInput{CategoryID: &missing, Title: "T", Content: "C", Position: 1, Status: domain.StatusPublished})
+	if !isValidation(err) {
+		t.Errorf("create in missing category: err = %v, want validation error", err)
+	}
+}
+
+func testListActive(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	cat := mustCreateCategory(t, categories, "Delivery", "delivery")
+
+	third := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Third", Content: "C", Position: 3})
+	first := mustCreate(t, faqs, domain.CreateFAQInput{Title: "First", Content: "C", Position: 1})
+	second := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Second", Content: "C", Position: 2, PublishAt: &past})
+	inCategory := mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &cat.ID, Title: "Category", Content: "C", Position: 4})
+
+	inactive := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Inactive", Content: "C", Position: 4})
+	if _, err := faqs.Patch(ctx, inactive.ID, domain.FAQPatch{IsActive: domain.PatchField[bool]{Set: true}}); err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "Draft", Content: "C", Position: 5, Status: domain.StatusDraft})
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "Scheduled", Content: "C", Position: 6, PublishAt: &future})
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "Expired", Content: "C", Position: 7, ExpireAt: &past})
+	deleted := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Deleted", Content: "C", Position: 8})
+	if err := faqs.Delete(ctx, deleted.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	items := mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: now})
+	assertIDs(t, "all", items, first.ID, second.ID, third.ID, inCategory.ID)
+
+	items = mustListActive(t, faqs, ctx, domain.ListActiveFilter{CategoryID: &cat.ID, At: now})
+	assertIDs(t, "category", items, inCategory.ID)
+
+	items = mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: future.Add(time.Minute)})
+	if len(items) != 5 {
+		t.Errorf("later: got %d faqs, want 5 with the scheduled one", len(items))
+	}
+}
+
+func testLastModified(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	modified := func(name string, at time.Time) time.Time {
+		t.Helper()
+		out, err := faqs.ListActive(ctx, domain.ListActiveFilter{At: at})
+		if err != nil {
+			t.Fatalf("%s: list active: %v", name, err)
+		}
+		return out.LastModified
+	}
+	// each change must move the time past the one before it
+	moved := func(name string, before time.Time) time.Time {
+		t.Helper()
+		out := modified(name, time.Now())
+		if !out.After(before) {
+			t.Errorf("%s: last modified %v, want after %v", name, out, before)
+		}
+		return out
+	}
+
+	if got := modified("empty", time.Now()); !got.IsZero() {
+		t.Errorf("empty: last modified %v, want zero", got)
+	}
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1})
+	last := moved("create", time.Time{})
+
+	// a FAQ that leaves the list takes its update time with it
+	if _, err := faqs.Patch(ctx, created.ID, domain.FAQPatch{IsActive: domain.PatchField[bool]{Set: true}}); err != nil {
+		t.Fatalf("deactivate: %v", err)
+	}
+	last = moved("deactivate", last)
+	if err := faqs.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	last = moved("delete", last)
+	if err := faqs.Purge(ctx, created.ID); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	last = moved("purge", last)
+
+	live := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Returns", Content: "C", Position: 1})
+	last = moved("create again", last)
+	if _, err := faqs.UpsertTranslation(ctx, domain.UpsertTranslationInput{FAQID: live.ID, Locale: "de", Title: "T", Content: "C"}); err != nil {
+		t.Fatalf("upsert translation: %v", err)
+	}
+	last = moved("translate", last)
+	cat := mustCreateCategory(t, categories, "Billing", "billing")
+	last = moved("create category", last)
+	if _, err := categories.Update(ctx, cat.ID, domain.UpdateCategoryInput{Name: "Payments", Slug: "payments", Position: 1}); err != nil {
+		t.Fatalf("update category: %v", err)
+	}
+	last = moved("update category", last)
+
+	// passing a publish or expire time changes the list without a write
+	expireAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "Sale", Content: "C", Position: 2, ExpireAt: &expireAt})
+	last = moved("create expiring", last)
+	if got := modified("before expiry", expireAt.Add(-time.Minute)); !got.Equal(last) {
+		t.Errorf("before expiry: last modified %v, want %v", got, last)
+	}
+	if got := modified("after expiry", expireAt.Add(time.Minute)); !got.Equal(expireAt) {
+		t.Errorf("after expiry: last modified %v, want the expire time %v", got, expireAt)
+	}
+
+	other := domain.WithTenant(ctx, "globex")
+	if out, err := faqs.ListActive(other, domain.ListActiveFilter{At: time.Now()}); err != nil || !out.LastModified.IsZero() {
+		t.Errorf("other tenant: last modified %v, %v, want zero", out.LastModified, err)
+	}
+}
+
+func testPositionUnique(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	cat := mustCreateCategory(t, categories, "Payment", "payment")
+
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "A", Content: "C", Position: 1})
+	_, err := faqs.Create(ctx, domain.CreateFAQInput{Title: "B", Content: "C", Position: 1, Status: domain.StatusPublished})
+	if !isValidation(err) {
+		t.Errorf("duplicate position: err = %v, want validation error", err)
+	}
+	mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &cat.ID, Title: "B", Content: "C", Position: 1})
+
+	deleted := mustCreate(t, faqs, domain.CreateFAQInput{Title: "D", Content: "C", Position: 2})
+	if err := faqs.Delete(ctx, deleted.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "E", Content: "C", Position: 2})
+}
+
+func testUpdate(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Old", Content: "C", Position: 1})
+
+	updated, err := faqs.Update(ctx, created.ID, domain.UpdateFAQInput{Title: "New", Content: "D", Position: 2, IsActive: true, Version: 1})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Title != "New" || updated.Content != "D" || updated.Position != 2 {
+		t.Errorf("got %+v", updated)
+	}
+	if updated.Version != 2 {
+		t.Errorf("version = %d, want 2", updated.Version)
+	}
+	if !updated.CreatedAt.Equal(created.CreatedAt) || updated.UpdatedAt.Before(created.UpdatedAt) {
+		t.Errorf("timestamps = %v / %v after %v / %v", updated.CreatedAt, updated.UpdatedAt, created.CreatedAt, created.UpdatedAt)
+	}
+
+	_, err = faqs.Update(ctx, created.ID, domain.UpdateFAQInput{Title: "Stale", Content: "C", Position: 1, Version: 1})
+	var conflict domain.VersionConflictError
+	if !errors.As(err, &conflict) || conflict.Current != 2 {
+		t.Errorf("stale update: err = %v, want version conflict at 2", err)
+	}
+
+	publishAt := time.Now().Add(time.Hour)
+	expireAt := publishAt.Add(-time.Minute)
+	_, err = faqs.Update(ctx, created.ID, domain.UpdateFAQInput{Title: "T", Content: "C", Position: 1, PublishAt: &publishAt, ExpireAt: &expireAt})
+	if !isValidation(err) {
+		t.Errorf("inverted schedule: err = %v, want validation error", err)
+	}
+}
+
+func testPatch(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	cat := mustCreateCategory(t, categories, "Returns", "returns")
+	created := mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &cat.ID, Title: "Title", Content: "Content", Position: 1})
+
+	patched, err := faqs.Patch(ctx, created.ID, domain.FAQPatch{
+		Title:      domain.PatchField[string]{Set: true, Value: "Patched"},
+		CategoryID: domain.PatchField[*uuid.UUID]{Set: true},
+	})
+	if err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+	if patched.Title != "Patched" || patched.Content != "Content" || patched.CategoryID != nil || patched.Position != 1 {
+		t.Errorf("got %+v", patched)
+	}
+	if patched.Version != 2 {
+		t.Errorf("version = %d, want 2", patched.Version)
+	}
+
+	_, err = faqs.Patch(ctx, created.ID, domain.FAQPatch{Title: domain.PatchField[string]{Set: true, Value: "X"}, Version: 1})
+	var conflict domain.VersionConflictError
+	if !errors.As(err, &conflict) || conflict.Current != 2 {
+		t.Errorf("stale patch: err = %v, want version conflict at 2", err)
+	}
+	if _, err := faqs.Patch(ctx, uuid.New(), domain.FAQPatch{Title: domain.PatchField[string]{Set: true, Value: "X"}}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("patch missing: err = %v, want ErrNotFound", err)
+	}
+}
+
+func testContentFormat(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	plain := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Plain", Content: "C", Position: 1})
+	if plain.ContentFormat != domain.ContentPlain {
+		t.Errorf("default format = %q, want plain", plain.ContentFormat)
+	}
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Rich", Content: "**C**", ContentFormat: domain.ContentMarkdown, Position: 2})
+
+	updated, err := faqs.Update(ctx, created.ID, domain.UpdateFAQInput{Title: "Rich", Content: "*C*", Position: 2, IsActive: true})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.ContentFormat != domain.ContentMarkdown {
+		t.Errorf("format after update without one = %q, want markdown", updated.ContentFormat)
+	}
+	patched, err := faqs.Patch(ctx, created.ID, domain.FAQPatch{
+		Content:       domain.PatchField[string]{Set: true, Value: "<p>C</p>"},
+		ContentFormat: domain.PatchField[domain.ContentFormat]{Set: true, Value: domain.ContentHTML},
+	})
+	if err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+	if got, err := faqs.GetByID(ctx, created.ID); err != nil || got.ContentFormat != domain.ContentHTML || patched.ContentFormat != domain.ContentHTML {
+		t.Errorf("format after patch = %q, %v, want html", got.ContentFormat, err)
+	}
+
+	restored, err := faqs.RestoreRevision(ctx, created.ID, 1)
+	if err != nil {
+		t.Fatalf("restore revision: %v", err)
+	}
+	if restored.ContentFormat != domain.ContentMarkdown || restored.Content != "**C**" {
+		t.Errorf("restored = %q in %q, want **C** in markdown", restored.Content, restored.ContentFormat)
+	}
+
+	_, err = faqs.Patch(ctx, created.ID, domain.FAQPatch{ContentFormat: domain.PatchField[domain.ContentFormat]{Set: true, Value: "rtf"}})
+	if !errors.As(err, new(domain.ValidationError)) {
+		t.Errorf("unknown format: err = %v, want a validation error", err)
+	}
+}
+
+func testList(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	for i, title := range []string{"delta", "alpha", "charlie", "bravo", "echo"} {
+		status := domain.StatusPublished
+		if title == "echo" {
+			status = domain.StatusDraft
+		}
+		mustCreate(t, faqs, domain.CreateFAQInput{Title: title, Content: "Body of " + title, Position: i + 1, Status: status})
+	}
+
+	filter := domain.FAQListFilter{Sort: domain.SortByTitle, Limit: 2}
+	var titles []string
+	for page := 0; page < 5; page++ {
+		items, err := faqs.List(ctx, filter)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		for _, it := range items {
+			titles = append(titles, it.Title)
+		}
+		if len(items) < filter.Limit {
+			break
+		}
+		cursor := domain.NewFAQCursor(filter.Sort, filter.Desc, items[len(items)-1])
+		filter.After = &cursor
+	}
+	if got := strings.Join(titles, ","); got != "alpha,bravo,charlie,delta,echo" {
+		t.Errorf("pages by title = %s", got)
+	}
+
+	items, err := faqs.List(ctx, domain.FAQListFilter{Sort: domain.SortByPosition, Desc: true, Status: domain.StatusPublished, Query: "BODY OF", Limit: 10})
+	if err != nil {
+		t.Fatalf("list filtered: %v", err)
+	}
+	titles = titles[:0]
+	for _, it := range items {
+		titles = append(titles, it.Title)
+	}
+	if got := strings.Join(titles, ","); got != "bravo,charlie,alpha,delta" {
+		t.Errorf("published by position desc = %s", got)
+	}
+
+	if _, err := faqs.List(ctx, domain.FAQListFilter{Sort: "nope"}); !isValidation(err) {
+		t.Errorf("invalid sort: err = %v, want validation error", err)
+	}
+}
+
+func testSearch(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	shipping := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Shipping costs", Content: "Shipping is free over <50>", Position: 1})
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "Returns", Content: "Send it back", Position: 2})
+	mustCreate(t, faqs, domain.CreateFAQInput{Title: "Shipping abroad", Content: "Hidden", Position: 3, Status: domain.StatusDraft})
+
+	res, err := faqs.Search(ctx, domain.SearchQuery{Query: "shipping", Language: "simple", Limit: 10, At: time.Now()})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(res) != 1 || res[0].FAQ.ID != shipping.ID {
+		t.Fatalf("got %d results, want the published shipping faq", len(res))
+	}
+	if res[0].Rank <= 0 {
+		t.Errorf("rank = %v, want positive", res[0].Rank)
+	}
+	if !strings.Contains(res[0].TitleHighlight, "<mark>Shipping</mark>") {
+		t.Errorf("title highlight = %q", res[0].TitleHighlight)
+	}
+	if strings.Contains(res[0].ContentHighlight, "<50>") {
+		t.Errorf("content highlight is not escaped: %q", res[0].ContentHighlight)
+	}
+
+	res, err = faqs.Search(ctx, domain.SearchQuery{Query: "shipping -free", Language: "simple", Limit: 10, At: time.Now()})
+	if err != nil {
+		t.Fatalf("search with exclusion: %v", err)
+	}
+	if len(res) != 0 {
+		t.Errorf("excluded term: got %d results, want 0", len(res))
+	}
+}
+
+func testTranslations(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	first := mustCreate(t, faqs, domain.CreateFAQInput{Title: "One", Content: "C", Position: 1})
+	second := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Two", Content: "C", Position: 2})
+
+	for _, locale := range []string{"fr", "de"} {
+		if _, err := faqs.UpsertTranslation(ctx, domain.UpsertTranslationInput{FAQID: first.ID, Locale: locale, Title: "T " + locale, Content: "C"}); err != nil {
+			t.Fatalf("upsert %s: %v", locale, err)
+		}
+	}
+	created, err := faqs.ListTranslations(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(created) != 2 || created[0].Locale != "de" || created[1].Locale != "fr" {
+		t.Fatalf("translations = %+v, want de and fr", created)
+	}
+
+	updated, err := faqs.UpsertTranslation(ctx, domain.UpsertTranslationInput{FAQID: first.ID, Locale: "de", Title: "Eins", Content: "C"})
+	if err != nil {
+		t.Fatalf("update translation: %v", err)
+	}
+	if updated.Title != "Eins" || !updated.CreatedAt.Equal(created[0].CreatedAt) {
+		t.Errorf("updated translation = %+v", updated)
+	}
+
+	found, err := faqs.FindTranslations(ctx, []uuid.UUID{first.ID, second.ID}, []string{"de"})
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if len(found) != 1 || found[0].FAQID != first.ID {
+		t.Errorf("found = %+v", found)
+	}
+
+	missing, err := faqs.ListMissingTranslations(ctx, "de")
+	if err != nil {
+		t.Fatalf("missing: %v", err)
+	}
+	assertIDs(t, "missing", missing, second.ID)
+
+	if err := faqs.DeleteTranslation(ctx, first.ID, "de"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := faqs.DeleteTranslation(ctx, first.ID, "de"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("delete again: err = %v, want ErrNotFound", err)
+	}
+	_, err = faqs.UpsertTranslation(ctx, domain.UpsertTranslationInput{FAQID: uuid.New(), Locale: "de", Title: "T", Content: "C"})
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("upsert for missing faq: err = %v, want ErrNotFound", err)
+	}
+
+	events := assertAudit(t, ctx, r.Audit, first.ID,
+		domain.AuditCreate, domain.AuditCreate, domain.AuditCreate, domain.AuditUpdate, domain.AuditDelete)
+	for _, e := range events {
+		switch {
+		case e.Action == domain.AuditUpdate && (e.Locale != "de" || e.Before.Title != "T de" || e.After.Title != "Eins"):
+			t.Errorf("translation update event = %+v", e)
+		case e.Action == domain.AuditDelete && (e.Locale != "de" || e.Before.Title != "Eins" || e.After != nil):
+			t.Errorf("translation delete event = %+v", e)
+		}
+	}
+}
+
+func testRevisions(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := domain.WithActor(context.Background(), "alice")
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "v1", Content: "C", Position: 1})
+	if _, err := faqs.Update(ctx, created.ID, domain.UpdateFAQInput{Title: "v2", Content: "C", Position: 1, IsActive: true}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := faqs.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	revs, err := faqs.ListRevisions(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(revs) != 3 {
+		t.Fatalf("got %d revisions, want 3", len(revs))
+	}
+	wantActions := []domain.RevisionAction{domain.RevisionDelete, domain.RevisionUpdate, domain.RevisionCreate}
+	for i, rev := range revs {
+		if rev.Number != 3-i || rev.Action != wantActions[i] {
+			t.Errorf("revision %d = #%d %s, want #%d %s", i, rev.Number, rev.Action, 3-i, wantActions[i])
+		}
+	}
+	if revs[1].Actor != "alice" || revs[1].Snapshot.Title != "v2" {
+		t.Errorf("update revision = %+v", revs[1])
+	}
+
+	restored, err := faqs.RestoreRevision(ctx, created.ID, 1)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.Title != "v1" || restored.DeletedAt != nil || restored.Version != 4 {
+		t.Errorf("restored = %+v", restored)
+	}
+	if _, err := faqs.GetRevision(ctx, created.ID, 4); err != nil {
+		t.Errorf("restore revision: %v", err)
+	}
+	if _, err := faqs.GetRevision(ctx, created.ID, 9); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get missing revision: err = %v, want ErrNotFound", err)
+	}
+
+	if err := faqs.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := faqs.Purge(ctx, created.ID); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	recreated, err := faqs.RestoreRevision(ctx, created.ID, 2)
+	if err != nil {
+		t.Fatalf("restore purged: %v", err)
+	}
+	if recreated.Title != "v2" || !recreated.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("recreated = %+v", recreated)
+	}
+	if _, err := faqs.RestoreRevision(ctx, created.ID, 1); err != nil {
+		t.Fatalf("restore live: %v", err)
+	}
+
+	// a revision brings a FAQ back from the trash or after a purge, and
+	// only changes a live one
+	assertAudit(t, ctx, r.Audit, created.ID,
+		domain.AuditCreate, domain.AuditUpdate, domain.AuditDelete, domain.AuditRestore,
+		domain.AuditDelete, domain.AuditPurge, domain.AuditRestore, domain.AuditUpdate)
+}
+
+// testConcurrentRestore recreates a purged FAQ from several requests at
+// once. There is no row to lock, yet every restore must get its own
+// revision number.
+func testConcurrentRestore(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "v1", Content: "C", Position: 1})
+	if err := faqs.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := faqs.Purge(ctx, created.ID); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+
+	const n = 4
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = faqs.RestoreRevision(ctx, created.ID, 1)
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("restore %d: %v", i, err)
+		}
+	}
+
+	revs, err := faqs.ListRevisions(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("list revisions: %v", err)
+	}
+	if len(revs) != 2+n {
+		t.Fatalf("got %d revisions, want %d", len(revs), 2+n)
+	}
+	for i, rev := range revs {
+		if rev.Number != len(revs)-i {
+			t.Errorf("revision %d is #%d, want #%d", i, rev.Number, len(revs)-i)
+		}
+	}
+}
+
+func testWorkflow(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	created := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Live", Content: "Live content", Position: 1})
+
+	drafted, err := faqs.SaveDraft(ctx, created.ID, domain.FAQDraft{Title: "Next", Content: "Next content"})
+	if err != nil {
+		t.Fatalf("save draft: %v", err)
+	}
+	if drafted.Status != domain.StatusDraft || drafted.Title != "Live" || drafted.Draft == nil {
+		t.Errorf("drafted = %+v", drafted)
+	}
+
+	if _, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusPublished, domain.StatusInReview); !isValidation(err) {
+		t.Errorf("wrong from: err = %v, want validation error", err)
+	}
+	if _, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusDraft, domain.StatusInReview); err != nil {
+		t.Fatalf("to review: %v", err)
+	}
+	published, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusInReview, domain.StatusPublished)
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if published.Title != "Next" || published.Content != "Next content" || published.Draft != nil {
+		t.Errorf("published = %+v", published)
+	}
+	if published.Version != 4 {
+		t.Errorf("version = %d, want 4", published.Version)
+	}
+
+	archived, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusPublished, domain.StatusArchived)
+	if err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+	if archived.PublishedAt != nil {
+		t.Errorf("archived faq keeps published_at %v", archived.PublishedAt)
+	}
+	if _, err := faqs.SaveDraft(ctx, created.ID, domain.FAQDraft{Title: "T", Content: "C"}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("draft of archived: err = %v, want ErrNotFound", err)
+	}
+
+	// the archived answer only comes back once it is published again
+	if _, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusArchived, domain.StatusDraft); err != nil {
+		t.Fatalf("archived to draft: %v", err)
+	}
+	assertIDs(t, "active after archived to draft", mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: time.Now()}))
+	if _, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusDraft, domain.StatusInReview); err != nil {
+		t.Fatalf("to review again: %v", err)
+	}
+	if _, err := faqs.ChangeStatus(ctx, created.ID, domain.StatusInReview, domain.StatusPublished); err != nil {
+		t.Fatalf("publish again: %v", err)
+	}
+	assertIDs(t, "active after publishing again", mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: time.Now()}), created.ID)
+
+	events := assertAudit(t, ctx, r.Audit, created.ID,
+		domain.AuditCreate, domain.AuditUpdate, domain.AuditUpdate, domain.AuditUpdate, domain.AuditUpdate,
+		domain.AuditUpdate, domain.AuditUpdate, domain.AuditUpdate)
+	publishes := slices.ContainsFunc(events, func(e domain.AuditEvent) bool {
+		return e.Before != nil && e.Before.Status == domain.StatusInReview &&
+			e.After.Status == domain.StatusPublished && e.After.Title == "Next"
+	})
+	if !publishes {
+		t.Errorf("no audit event publishes the draft in %+v", events)
+	}
+}
+
+func testTrash(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	first := mustCreate(t, faqs, domain.CreateFAQInput{Title: "First", Content: "C", Position: 1})
+	second := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Second", Content: "C", Position: 2})
+	if _, err := faqs.UpsertTranslation(ctx, domain.UpsertTranslationInput{FAQID: second.ID, Locale: "de", Title: "T", Content: "C"}); err != nil {
+		t.Fatalf("upsert translation: %v", err)
+	}
+
+	if err := faqs.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("delete first: %v", err)
+	}
+	if err := faqs.Delete(ctx, first.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("delete again: err = %v, want ErrNotFound", err)
+	}
+	if _, err := faqs.GetByID(ctx, first.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get deleted: err = %v, want ErrNotFound", err)
+	}
+	if err := faqs.Delete(ctx, second.ID); err != nil {
+		t.Fatalf("delete second: %v", err)
+	}
+
+	deleted, err := faqs.ListDeleted(ctx)
+	if err != nil {
+		t.Fatalf("list deleted: %v", err)
+	}
+	assertIDs(t, "trash", deleted, second.ID, first.ID)
+
+	// the position of the first FAQ is taken while it is in the trash
+	taken := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Taken", Content: "C", Position: 1})
+	restored, err := faqs.Restore(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.DeletedAt != nil || restored.Position != 2 {
+		t.Errorf("restored = %+v, want position 2 after %s", restored, taken.ID)
+	}
+	if _, err := faqs.Restore(ctx, first.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("restore live: err = %v, want ErrNotFound", err)
+	}
+
+	if err := faqs.Purge(ctx, first.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("purge live: err = %v, want ErrNotFound", err)
+	}
+	if err := faqs.Purge(ctx, second.ID); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if _, err := faqs.Restore(ctx, second.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("restore purged: err = %v, want ErrNotFound", err)
+	}
+	if items, err := faqs.ListTranslations(ctx, second.ID); err != nil || len(items) != 0 {
+		t.Errorf("translations of purged faq = %v, %v", items, err)
+	}
+	if revs, err := faqs.ListRevisions(ctx, second.ID); err != nil || len(revs) == 0 {
+		t.Errorf("revisions of purged faq = %d, %v, want kept", len(revs), err)
+	}
+
+	if err := faqs.Delete(ctx, taken.ID); err != nil {
+		t.Fatalf("delete taken: %v", err)
+	}
+	purged, err := faqs.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+	if err != nil || purged != 0 {
+		t.Errorf("purge old = %d, %v, want 0", purged, err)
+	}
+	purged, err = faqs.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+	if err != nil || purged != 1 {
+		t.Errorf("purge all = %d, %v, want 1", purged, err)
+	}
+
+	assertAudit(t, ctx, r.Audit, first.ID, domain.AuditCreate, domain.AuditDelete, domain.AuditRestore)
+	// the translation of the second FAQ is audited under it as well
+	events := assertAudit(t, ctx, r.Audit, second.ID,
+		domain.AuditCreate, domain.AuditCreate, domain.AuditDelete, domain.AuditPurge)
+	for _, e := range events {
+		if e.Action == domain.AuditPurge && (e.Before == nil || e.Before.Title != "Second" || e.After != nil) {
+			t.Errorf("purge event = %+v", e)
+		}
+	}
+	assertAudit(t, ctx, r.Audit, taken.ID, domain.AuditCreate, domain.AuditDelete, domain.AuditPurge)
+}
+
+func testOrder(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	cat := mustCreateCategory(t, categories, "Account", "account")
+	a := mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &cat.ID, Title: "A", Content: "C", Position: 1})
+	b := mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &cat.ID, Title: "B", Content: "C", Position: 2})
+	c := mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &cat.ID, Title: "C", Content: "C", Position: 3})
+	other := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Other", Content: "C", Position: 1})
+
+	items, err := faqs.Reorder(ctx, domain.ReorderInput{CategoryID: &cat.ID, IDs: []uuid.UUID{c.ID, a.ID, b.ID}})
+	if err != nil {
+		t.Fatalf("reorder: %v", err)
+	}
+	assertIDs(t, "reorder", items, c.ID, a.ID, b.ID)
+	for i, it := range items {
+		if it.Position != i+1 {
+			t.Errorf("%s at position %d, want %d", it.Title, it.Position, i+1)
+		}
+	}
+	if items[0].Version != 2 {
+		t.Errorf("moved faq version = %d, want 2", items[0].Version)
+	}
+
+	if _, err := faqs.Reorder(ctx, domain.ReorderInput{CategoryID: &cat.ID, IDs: []uuid.UUID{c.ID, a.ID}}); !isValidation(err) {
+		t.Errorf("partial reorder: err = %v, want validation error", err)
+	}
+
+	items, err = faqs.Move(ctx, domain.MoveInput{ID: c.ID, Target: b.ID, After: true})
+	if err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	assertIDs(t, "move", items, a.ID, b.ID, c.ID)
+
+	if _, err := faqs.Move(ctx, domain.MoveInput{ID: a.ID, Target: other.ID}); !isValidation(err) {
+		t.Errorf("move across categories: err = %v, want validation error", err)
+	}
+	if _, err := faqs.Move(ctx, domain.MoveInput{ID: uuid.New(), Target: a.ID}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("move missing: err = %v, want ErrNotFound", err)
+	}
+
+	// every FAQ that changed its position is audited, the others are not
+	events := assertAudit(t, ctx, r.Audit, c.ID, domain.AuditCreate, domain.AuditUpdate, domain.AuditUpdate)
+	for _, e := range events {
+		if e.Action == domain.AuditUpdate && e.Before.Position == e.After.Position {
+			t.Errorf("reorder event keeps position %d", e.After.Position)
+		}
+	}
+	assertAudit(t, ctx, r.Audit, a.ID, domain.AuditCreate, domain.AuditUpdate, domain.AuditUpdate)
+	assertAudit(t, ctx, r.Audit, other.ID, domain.AuditCreate)
+}
+
+func testCategories(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	ctx := context.Background()
+	parent := mustCreateCategory(t, categories, "Orders", "orders")
+	child, err := categories.Create(ctx, domain.CreateCategoryInput{ParentID: &parent.ID, Name: "Tracking", Slug: "tracking", Position: 1})
+	if err != nil {
+		t.Fatalf("create child: %v", err)
+	}
+	second, err := categories.Create(ctx, domain.CreateCategoryInput{Name: "Billing", Slug: "billing", Position: 1})
+	if err != nil {
+		t.Fatalf("create second: %v", err)
+	}
+
+	if _, err := categories.Create(ctx, domain.CreateCategoryInput{Name: "Dup", Slug: "orders", Position: 2}); !isValidation(err) {
+		t.Errorf("duplicate slug: err = %v, want validation error", err)
+	}
+
+	list, err := categories.List(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	var names []string
+	for _, c := range list {
+		names = append(names, c.Name)
+	}
+	if got := strings.Join(names, ","); got != "Billing,Orders,Tracking" {
+		t.Errorf("categories = %s", got)
+	}
+
+	updated, err := categories.Update(ctx, second.ID, domain.UpdateCategoryInput{Name: "Payments", Slug: "payments", Position: 5})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Name != "Payments" || updated.UpdatedAt.Before(second.UpdatedAt) {
+		t.Errorf("updated = %+v", updated)
+	}
+
+	uncategorized := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Loose", Content: "C", Position: 1})
+	inParent := mustCreate(t, faqs, domain.CreateFAQInput{CategoryID: &parent.ID, Title: "Where", Content: "C", Position: 1})
+
+	if err := categories.Delete(ctx, parent.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	got, err := categories.GetByID(ctx, child.ID)
+	if err != nil {
+		t.Fatalf("get child: %v", err)
+	}
+	if got.ParentID != nil {
+		t.Errorf("child parent = %v, want nil", got.ParentID)
+	}
+	moved, err := faqs.GetByID(ctx, inParent.ID)
+	if err != nil {
+		t.Fatalf("get moved faq: %v", err)
+	}
+	if moved.CategoryID != nil || moved.Position != 2 {
+		t.Errorf("faq of deleted category = %+v, want uncategorized at 2 after %s", moved, uncategorized.ID)
+	}
+	events := assertAudit(t, ctx, r.Audit, inParent.ID, domain.AuditCreate, domain.AuditUpdate)
+	for _, e := range events {
+		if e.Action == domain.AuditUpdate && (e.Before.CategoryID == nil || e.After.CategoryID != nil) {
+			t.Errorf("detach event = %+v", e)
+		}
+	}
+	assertAudit(t, ctx, r.Audit, uncategorized.ID, domain.AuditCreate)
+}
+
+func testAPIKeys(t *testing.T, r Repos) {
+	ctx := context.Background()
+	keys := r.APIKeys
+
+	first, err := keys.Create(ctx, domain.CreateAPIKeyInput{Name: "deploy", Role: domain.RoleEditor, Hash: "hash-1"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if first.ID == uuid.Nil || first.CreatedAt.IsZero() || first.RevokedAt != nil {
+		t.Errorf("created %+v", first)
+	}
+	second, err := keys.Create(ctx, domain.CreateAPIKeyInput{Name: "ci", Role: domain.RoleAdmin, Hash: "hash-2"})
+	if err != nil {
+		t.Fatalf("create second: %v", err)
+	}
+	if _, err := keys.Create(ctx, domain.CreateAPIKeyInput{Name: " ", Role: domain.RoleAdmin, Hash: "hash-3"}); !isValidation(err) {
+		t.Errorf("create without name: err = %v, want validation error", err)
+	}
+	if _, err := keys.Create(ctx, domain.CreateAPIKeyInput{Name: "root", Role: "root", Hash: "hash-3"}); !isValidation(err) {
+		t.Errorf("create with unknown role: err = %v, want validation error", err)
+	}
+
+	got, err := keys.GetByHash(ctx, "hash-2")
+	if err != nil {
+		t.Fatalf("get by hash: %v", err)
+	}
+	if got.ID != second.ID || got.Name != "ci" || got.Role != domain.RoleAdmin {
+		t.Errorf("got %+v, want %+v", got, second)
+	}
+	if _, err := keys.GetByHash(ctx, "unknown"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get unknown hash: err = %v, want not found", err)
+	}
+
+	if err := keys.Revoke(ctx, first.ID); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	revoked, err := keys.GetByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("get revoked: %v", err)
+	}
+	if revoked.RevokedAt == nil {
+		t.Fatal("revoked key has no revoked_at")
+	}
+	if err := keys.Revoke(ctx, first.ID); err != nil {
+		t.Errorf("revoke again: %v", err)
+	}
+	again, _ := keys.GetByHash(ctx, "hash-1")
+	if again.RevokedAt == nil || !again.RevokedAt.Equal(*revoked.RevokedAt) {
+		t.Errorf("revoking again moved revoked_at from %v to %v", revoked.RevokedAt, again.RevokedAt)
+	}
+	if err := keys.Revoke(ctx, uuid.New()); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("revoke unknown key: err = %v, want not found", err)
+	}
+
+	list, err := keys.List(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != first.ID || list[1].ID != second.ID {
+		t.Errorf("list = %+v, want keys in creation order", list)
+	}
+}
+
+func testAudit(t *testing.T, r Repos) {
+	ctx := context.Background()
+	audit := r.Audit
+	faqID, otherID := uuid.New(), uuid.New()
+	snap := domain.FAQSnapshot{ID: faqID, Title: "Shipping", Content: "Two days", Position: 1, Version: 1}
+	changed := snap
+	changed.Title, changed.Version = "Delivery", 2
+
+	appendEvent := func(in domain.CreateAuditEventInput) domain.AuditEvent {
+		t.Helper()
+		out, err := audit.Append(ctx, in)
+		if err != nil {
+			t.Fatalf("append %s: %v", in.Action, err)
+		}
+		return out
+	}
+	created := appendEvent(domain.CreateAuditEventInput{
+		Action: domain.AuditCreate, Actor: "alice", FAQID: faqID, After: &snap, RequestID: "req-1", ClientIP: "10.0.0.1",
+	})
+	updated := appendEvent(domain.CreateAuditEventInput{
+		Action: domain.AuditUpdate, Actor: "bob", FAQID: faqID, Before: &snap, After: &changed,
+	})
+	other := appendEvent(domain.CreateAuditEventInput{Action: domain.AuditCreate, Actor: "alice", FAQID: otherID})
+	deleted := appendEvent(domain.CreateAuditEventInput{Action: domain.AuditDelete, Actor: "alice", FAQID: faqID, Before: &changed})
+
+	if created.ID == uuid.Nil || created.CreatedAt.IsZero() || created.RequestID != "req-1" || created.ClientIP != "10.0.0.1" {
+		t.Errorf("created %+v", created)
+	}
+	if _, err := audit.Append(ctx, domain.CreateAuditEventInput{Action: "rename", FAQID: faqID}); !isValidation(err) {
+		t.Errorf("append unknown action: err = %v, want validation error", err)
+	}
+	if _, err := audit.Append(ctx, domain.CreateAuditEventInput{Action: domain.AuditCreate}); !isValidation(err) {
+		t.Errorf("append without faq: err = %v, want validation error", err)
+	}
+
+	list := func(name string, filter domain.AuditFilter, want ...domain.AuditEvent) []domain.AuditEvent {
+		t.Helper()
+		got, err := audit.List(ctx, filter)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(got) != len(want) {
+			t.Errorf("%s: got %d events, want %d", name, len(got), len(want))
+			return got
+		}
+		for i := range want {
+			if got[i].ID != want[i].ID {
+				t.Errorf("%s: event %d is %s by %s, want another one", name, i, got[i].Action, got[i].Actor)
+			}
+		}
+		return got
+	}
+
+	all := list("all", domain.AuditFilter{}, deleted, other, updated, created)
+	if all[3].Before != nil || all[3].After == nil || all[3].After.Title != "Shipping" {
+		t.Errorf("create event snapshots = %+v / %+v, want only after", all[3].Before, all[3].After)
+	}
+	if all[2].Before == nil || all[2].Before.Title != "Shipping" || all[2].After == nil || all[2].After.Title != "Delivery" {
+		t.Errorf("update event snapshots = %+v / %+v", all[2].Before, all[2].After)
+	}
+	if all[0].After != nil {
+		t.Errorf("delete event has after snapshot %+v", all[0].After)
+	}
+
+	list("by actor", domain.AuditFilter{Actor: "bob"}, updated)
+	list("by faq", domain.AuditFilter{FAQID: &faqID}, deleted, updated, created)
+	list("from", domain.AuditFilter{From: &other.CreatedAt}, deleted, other)
+	list("to", domain.AuditFilter{To: &other.CreatedAt}, updated, created)
+
+	first := list("first page", domain.AuditFilter{Limit: 2}, deleted, other)
+	after := domain.NewAuditCursor(first[1])
+	list("second page", domain.AuditFilter{Limit: 2, After: &after}, updated, created)
+}
+
+func testTenants(t *testing.T, r Repos) {
+	faqs, categories := r.FAQs, r.Categories
+	acme := domain.WithTenant(context.Background(), "acme")
+	globex := domain.WithTenant(context.Background(), "globex")
+
+	// slugs and positions are unique per tenant
+	newCategory := func(ctx context.Context) domain.Category {
+		t.Helper()
+		c, err := categories.Create(ctx, domain.CreateCategoryInput{Name: "Billing", Slug: "billing", Position: 1})
+		if err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+		return c
+	}
+	newFAQ := func(ctx context.Context, categoryID *uuid.UUID) domain.FAQ {
+		t.Helper()
+		f, err := faqs.Create(ctx, domain.CreateFAQInput{
+			CategoryID: categoryID, Title: "Refunds", Content: "C", Position: 1, IsActive: true, Status: domain.StatusPublished,
+		})
+		if err != nil {
+			t.Fatalf("create faq: %v", err)
+		}
+		return f
+	}
+	acmeCat, globexCat := newCategory(acme), newCategory(globex)
+	acmeFAQ, globexFAQ := newFAQ(acme, &acmeCat.ID), newFAQ(globex, &globexCat.ID)
+	if _, err := faqs.Create(acme, domain.CreateFAQInput{CategoryID: &globexCat.ID, Title: "X", Content: "C", Position: 2, Status: domain.StatusPublished}); !isValidation(err) {
+		t.Errorf("create in category of another tenant: err = %v, want validation error", err)
+	}
+
+	items := mustListActive(t, faqs, acme, domain.ListActiveFilter{At: time.Now()})
+	assertIDs(t, "acme active", items, acmeFAQ.ID)
+	if items, err := categories.List(globex); err != nil || len(items) != 1 || items[0].ID != globexCat.ID {
+		t.Errorf("globex categories = %+v, %v", items, err)
+	}
+	if results, err := faqs.Search(globex, domain.SearchQuery{Query: "refunds", At: time.Now()}); err != nil || len(results) != 1 || results[0].FAQ.ID != globexFAQ.ID {
+		t.Errorf("globex search = %+v, %v", results, err)
+	}
+
+	if _, err := faqs.GetByID(globex, acmeFAQ.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get faq of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if _, err := categories.GetByID(globex, acmeCat.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("get category of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if _, err := faqs.Update(globex, acmeFAQ.ID, domain.UpdateFAQInput{Title: "X", Content: "C", Position: 1, Version: acmeFAQ.Version}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("update faq of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if _, err := faqs.UpsertTranslation(globex, domain.UpsertTranslationInput{FAQID: acmeFAQ.ID, Locale: "de", Title: "T", Content: "C"}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("translate faq of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if _, err := faqs.RestoreRevision(globex, acmeFAQ.ID, 1); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("restore revision of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if revs, err := faqs.ListRevisions(globex, acmeFAQ.ID); err != nil || len(revs) != 0 {
+		t.Errorf("revisions of another tenant = %d, %v, want none", len(revs), err)
+	}
+	if err := faqs.Delete(globex, acmeFAQ.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("delete faq of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if err := categories.Delete(globex, acmeCat.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("delete category of another tenant: err = %v, want ErrNotFound", err)
+	}
+
+	if err := faqs.Delete(acme, acmeFAQ.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if deleted, err := faqs.ListDeleted(globex); err != nil || len(deleted) != 0 {
+		t.Errorf("globex trash = %+v, %v, want empty", deleted, err)
+	}
+	if _, err := faqs.Restore(globex, acmeFAQ.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("restore faq of another tenant: err = %v, want ErrNotFound", err)
+	}
+	if err := faqs.Delete(globex, globexFAQ.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	purged, err := faqs.PurgeDeleted(acme, time.Now().Add(time.Hour))
+	if err != nil || purged != 1 {
+		t.Errorf("purge acme = %d, %v, want 1", purged, err)
+	}
+	purged, err = faqs.PurgeDeleted(domain.WithTenant(context.Background(), domain.AnyTenant), time.Now().Add(time.Hour))
+	if err != nil || purged != 1 {
+		t.Errorf("purge any tenant = %d, %v, want 1", purged, err)
+	}
+
+	if _, err := r.Audit.Append(acme, domain.CreateAuditEventInput{Action: domain.AuditCreate, Actor: "alice", FAQID: acmeFAQ.ID}); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if events, err := r.Audit.List(globex, domain.AuditFilter{FAQID: &acmeFAQ.ID}); err != nil || len(events) != 0 {
+		t.Errorf("globex audit of the acme faq = %+v, %v, want empty", events, err)
+	}
+	assertAudit(t, acme, r.Audit, acmeFAQ.ID, domain.AuditCreate, domain.AuditCreate, domain.AuditDelete, domain.AuditPurge)
+	// purging every tenant's trash audits each FAQ under its own tenant
+	assertAudit(t, globex, r.Audit, globexFAQ.ID, domain.AuditCreate, domain.AuditDelete, domain.AuditPurge)
+
+	key, err := r.APIKeys.Create(acme, domain.CreateAPIKeyInput{Name: "deploy", Role: domain.RoleEditor, Hash: "hash-acme"})
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	if key.Tenant != "acme" {
+		t.Errorf("key tenant = %q, want acme", key.Tenant)
+	}
+	// keys are looked up before the tenant of a request is trusted
+	if got, err := r.APIKeys.GetByHash(globex, "hash-acme"); err != nil || got.Tenant != "acme" {
+		t.Errorf("get by hash = %+v, %v, want the acme key", got, err)
+	}
+	if keys, err := r.APIKeys.List(globex); err != nil || len(keys) != 0 {
+		t.Errorf("globex keys = %+v, %v, want none", keys, err)
+	}
+	if err := r.APIKeys.Revoke(globex, key.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("revoke key of another tenant: err = %v, want ErrNotFound", err)
+	}
+}
+
+func testImport(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	first := mustCreate(t, faqs, domain.CreateFAQInput{Title: "First", Content: "C", Position: 1})
+	second := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Second", Content: "C", Position: 2})
+	kept := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Kept", Content: "C", Position: 3})
+	trashed := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Trashed", Content: "C", Position: 4})
+	if err := faqs.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	item := func(row int, f *domain.FAQ, title string, position int) domain.ImportItem {
+		it := domain.ImportItem{Row: row, Title: title, Content: "C", Position: position, IsActive: true, Status: domain.StatusPublished}
+		if f != nil {
+			it.ID = &f.ID
+		}
+		return it
+	}
+	newID := uuid.New()
+	added := domain.FAQ{ID: newID}
+	items := []domain.ImportItem{
+		item(1, &first, "First", 2),
+		item(2, &second, "Second!", 1),
+		item(3, &kept, "Kept", 3),
+		item(4, &added, "Added", 5),
+	}
+
+	res, err := faqs.Import(ctx, domain.ImportInput{Items: items, Strategy: domain.ImportUpsert, DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if len(res.Errors) != 0 || res.Count(domain.AuditUpdate) != 2 || res.Count(domain.AuditCreate) != 1 || res.Unchanged != 1 {
+		t.Errorf("dry run = %+v", res)
+	}
+	if _, err := faqs.GetByID(ctx, newID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("dry run stored a faq: err = %v", err)
+	}
+
+	bad := append([]domain.ImportItem{}, items...)
+	bad = append(bad, item(5, nil, "Clash", 3), item(6, &trashed, "Trashed", 6))
+	res, err = faqs.Import(ctx, domain.ImportInput{Items: bad, Strategy: domain.ImportUpsert})
+	if err != nil {
+		t.Fatalf("import with errors: %v", err)
+	}
+	if len(res.Errors) != 2 || res.Errors[0].Row != 5 || res.Errors[1].Row != 6 {
+		t.Errorf("errors = %+v, want rows 5 and 6", res.Errors)
+	}
+	if got, err := faqs.GetByID(ctx, second.ID); err != nil || got.Title != "Second" || got.Position != 2 {
+		t.Errorf("failed import changed a faq: %+v, %v", got, err)
+	}
+
+	res, err = faqs.Import(ctx, domain.ImportInput{Items: items, Strategy: domain.ImportUpsert})
+	if err != nil || len(res.Errors) != 0 {
+		t.Fatalf("import = %+v, %v", res, err)
+	}
+	active := mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: time.Now()})
+	assertIDs(t, "after upsert", active, second.ID, first.ID, kept.ID, newID)
+	if active[0].Title != "Second!" || active[0].Version != 2 || active[2].Version != 1 {
+		t.Errorf("after upsert: %+v", active)
+	}
+	if revs, err := faqs.ListRevisions(ctx, newID); err != nil || len(revs) != 1 {
+		t.Errorf("revisions of imported faq = %d, %v, want 1", len(revs), err)
+	}
+
+	res, err = faqs.Import(ctx, domain.ImportInput{Items: items[:1], Strategy: domain.ImportReplace})
+	if err != nil || len(res.Errors) != 0 || res.Count(domain.AuditDelete) != 3 || res.Unchanged != 1 {
+		t.Fatalf("replace = %+v, %v", res, err)
+	}
+	active = mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: time.Now()})
+	assertIDs(t, "after replace", active, first.ID)
+	if deleted, err := faqs.ListDeleted(ctx); err != nil || len(deleted) != 4 {
+		t.Errorf("trash after replace = %d, %v, want 4", len(deleted), err)
+	}
+}
+
+func testBatch(t *testing.T, r Repos) {
+	faqs := r.FAQs
+	ctx := context.Background()
+	first := mustCreate(t, faqs, domain.CreateFAQInput{Title: "First", Content: "C", Position: 1})
+	second := mustCreate(t, faqs, domain.CreateFAQInput{Title: "Second", Content: "C", Position: 2})
+
+	create := func(title string, position int) domain.BatchOperation {
+		return domain.BatchOperation{Action: domain.BatchCreate, Create: domain.CreateFAQInput{
+			Title: title, Content: "C", Position: position, IsActive: true, Status: domain.StatusPublished,
+		}}
+	}
+	update := func(f domain.FAQ, title string, position int) domain.BatchOperation {
+		return domain.BatchOperation{Action: domain.BatchUpdate, ID: f.ID, Update: domain.UpdateFAQInput{
+			Title: title, Content: "C", Position: position, IsActive: true, Version: f.Version,
+		}}
+	}
+	remove := domain.BatchOperation{Action: domain.BatchDelete, ID: first.ID}
+
+	_, err := faqs.Batch(ctx, []domain.BatchOperation{remove, create("Third", 3), update(second, "Clash", 3)})
+	var batchErr domain.BatchError
+	if !errors.As(err, &batchErr) || batchErr.Index != 2 {
+		t.Fatalf("failing batch: err = %v, want a BatchError for operation 2", err)
+	}
+	active := mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: time.Now()})
+	assertIDs(t, "after failed batch", active, first.ID, second.ID)
+
+	out, err := faqs.Batch(ctx, []domain.BatchOperation{remove, create("Third", 1), update(second, "Second!", 2)})
+	if err != nil {
+		t.Fatalf("batch: %v", err)
+	}
+	if len(out) != 3 || out[0].DeletedAt == nil || out[1].Title != "Third" || out[2].Version != second.Version+1 {
+		t.Errorf("batch = %+v", out)
+	}
+	active = mustListActive(t, faqs, ctx, domain.ListActiveFilter{At: time.Now()})
+	assertIDs(t, "after batch", active, out[1].ID, second.ID)
+	if revs, err := faqs.ListRevisions(ctx, out[1].ID); err != nil || len(revs) != 1 {
+		t.Errorf("revisions of created faq = %d, %v, want 1", len(revs), err)
+	}
+
+	if _, err := faqs.Batch(ctx, []domain.BatchOperation{update(second, "Stale", 2)}); !errors.As(err, new(domain.VersionConflictError)) {
+		t.Errorf("stale update: err = %v, want a version conflict", err)
+	}
+}
+
+func mustCreate(t *testing.T, faqs service.FAQRepository, in domain.CreateFAQInput) domain.FAQ {
+	t.Helper()
+	if in.Status == "" {
+		in.Status = domain.StatusPublished
+	}
+	in.IsActive = true
+	out, err := faqs.Create(context.Background(), in)
+	if err != nil {
+		t.Fatalf("create %q: %v", in.Title, err)
+	}
+	return out
+}
+
+func mustListActive(t *testing.T, faqs service.FAQRepository, ctx context.Context, filter domain.ListActiveFilter) []domain.FAQ {
+	t.Helper()
+	out, err := faqs.ListActive(ctx, filter)
+	if err != nil {
+		t.Fatalf("list active: %v", err)
+	}
+	return out.Items
+}
+
+func mustCreateCategory(t *testing.T, categories service.CategoryRepository, name, slug string) domain.Category {
+	t.Helper()
+	out, err := categories.Create(context.Background(), domain.CreateCategoryInput{Name: name, Slug: slug, Position: 1})
+	if err != nil {
+		t.Fatalf("create category %q: %v", name, err)
+	}
+	return out
+}
+
+func assertIDs(t *testing.T, name string, items []domain.FAQ, want ...uuid.UUID) {
+	t.Helper()
+	if len(items) != len(want) {
+		t.Errorf("%s: got %d faqs, want %d", name, len(items), len(want))
+		return
+	}
+	for i := range want {
+		if items[i].ID != want[i] {
+			t.Errorf("%s: faq %d is %q, want another one", name, i, items[i].Title)
+		}
+	}
+}
+
+// assertAudit checks the actions of the audit events of a FAQ in any
+// order, as events written in quick succession may share a timestamp, and
+// returns the events.
+func assertAudit(t *testing.T, ctx context.Context, audit service.AuditRepository, faqID uuid.UUID, want ...domain.AuditAction) []domain.AuditEvent {
+	t.Helper()
+	events, err := audit.List(ctx, domain.AuditFilter{FAQID: &faqID})
+	if err != nil {
+		t.Fatalf("list audit: %v", err)
+	}
+	got := make([]domain.AuditAction, 0, len(events))
+	for _, e := range events {
+		got = append(got, e.Action)
+	}
+	slices.Sort(got)
+	want = slices.Clone(want)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("audit of faq %s = %v, want %v", faqID, got, want)
+	}
+	return events
+}
+
+func isValidation(err error) bool {
+	var ve domain.ValidationError
+	return errors.As(err, &ve)
+}
@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const apiKeyColumns = `id, tenant, name, role, key_hash, created_at, revoked_at`
+
+type APIKeyRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db, now: time.Now}
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	const q = `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE tenant = ?1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.APIKey, 0)
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate api keys: %w", err)
+	}
+	return out, nil
+}
+
+// GetByHash looks a key up in every tenant: the key decides the tenant of
+// the request, not the other way round.
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	const q = `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE key_hash = ?1
+	`
+
+	out, err := scanAPIKey(r.db.QueryRowContext(ctx, q, hash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.APIKey{}, domain.ErrNotFound
+		}
+		return domain.APIKey{}, fmt.Errorf("get api key: %w", err)
+	}
+	return out, nil
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, in domain.CreateAPIKeyInput) (domain.APIKey, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return domain.APIKey{}, domain.ValidationError{Message: "name is required"}
+	}
+	if !in.Role.Valid() {
+		return domain.APIKey{}, domain.ValidationError{Message: "role must be viewer, editor, publisher or admin"}
+	}
+	if in.Hash == "" {
+		return domain.APIKey{}, domain.ValidationError{Message: "hash is required"}
+	}
+	const q = `
+		INSERT INTO api_keys (id, tenant, name, role, key_hash, created_at)
+		VALUES (?1, ?6, ?2, ?3, ?4, ?5)
+		RETURNING ` + apiKeyColumns
+
+	out, err := scanAPIKey(r.db.QueryRowContext(ctx, q, uuid.NewString(), in.Name, string(in.Role), in.Hash, formatTime(r.now()),
+		domain.TenantFromContext(ctx)))
+	if err != nil {
+		return domain.APIKey{}, fmt.Errorf("create api key: %w", err)
+	}
+	return out, nil
+}
+
+// Revoke disables a key. Revoking a revoked key keeps its revoked_at.
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	const q = `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, ?2)
+		WHERE id = ?1 AND tenant = ?3
+	`
+
+	res, err := r.db.ExecContext(ctx, q, id.String(), formatTime(r.now()), domain.TenantFromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("revoke api key: rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func scanAPIKey(s rowScanner) (domain.APIKey, error) {
+	var (
+		out       domain.APIKey
+		idRaw     string
+		createdAt string
+		revokedAt sql.NullString
+	)
+	if err := s.Scan(&idRaw, &out.Tenant, &out.Name, &out.Role, &out.Hash, &createdAt, &revokedAt); err != nil {
+		return domain.APIKey{}, fmt.Errorf("scan api key: %w", err)
+	}
+	var err error
+	if out.ID, err = uuid.Parse(idRaw); err != nil {
+		return domain.APIKey{}, fmt.Errorf("parse api key id: %w", err)
+	}
+	if out.CreatedAt, err = parseTime(createdAt); err != nil {
+		return domain.APIKey{}, err
+	}
+	if out.RevokedAt, err = timePtr(revokedAt); err != nil {
+		return domain.APIKey{}, err
+	}
+	return out, nil
+}
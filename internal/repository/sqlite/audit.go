@@ -0,0 +1,182 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const auditColumns = `id, action, actor, faq_id, locale, before, after, request_id, client_ip, created_at`
+
+type AuditRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db, now: time.Now}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, in domain.CreateAuditEventInput) (domain.AuditEvent, error) {
+	if !in.Action.Valid() {
+		return domain.AuditEvent{}, domain.ValidationError{Message: "action must be create, update, delete, restore or purge"}
+	}
+	if in.FAQID == uuid.Nil {
+		return domain.AuditEvent{}, domain.ValidationError{Message: "faq_id is required"}
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	out, err := appendAuditEvent(ctx, tx, in, r.now())
+	if err != nil {
+		return domain.AuditEvent{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// insertAuditEvent records a change of a FAQ in the audit log, dated at.
+// It must run in the transaction that made the change, so that the change
+// is never stored without its event.
+func insertAuditEvent(ctx context.Context, tx *sql.Tx, at time.Time, action domain.AuditAction, before, after *domain.FAQ) error {
+	_, err := appendAuditEvent(ctx, tx, domain.NewAuditEventInput(ctx, action, before, after), at)
+	return err
+}
+
+func appendAuditEvent(ctx context.Context, tx *sql.Tx, in domain.CreateAuditEventInput, at time.Time) (domain.AuditEvent, error) {
+	before, err := encodeSnapshot(in.Before)
+	if err != nil {
+		return domain.AuditEvent{}, err
+	}
+	after, err := encodeSnapshot(in.After)
+	if err != nil {
+		return domain.AuditEvent{}, err
+	}
+	const q = `
+		INSERT INTO audit_events (id, tenant, action, actor, faq_id, locale, before, after, request_id, client_ip, created_at)
+		VALUES (?1, ?10, ?2, ?3, ?4, ?11, ?5, ?6, ?7, ?8, ?9)
+		RETURNING ` + auditColumns
+
+	out, err := scanAuditEvent(tx.QueryRowContext(ctx, q, uuid.NewString(), string(in.Action), in.Actor,
+		in.FAQID.String(), before, after, in.RequestID, in.ClientIP, formatTime(at),
+		domain.TenantFromContext(ctx), in.Locale))
+	if err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("append audit event: %w", err)
+	}
+	return out, nil
+}
+
+// List returns audit events newest first, with the ID as a tie-breaker,
+// so the list can be continued from a cursor.
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	var actor, cursorTime, cursorID any
+	if filter.Actor != "" {
+		actor = filter.Actor
+	}
+	if filter.After != nil {
+		cursorTime, cursorID = formatTime(filter.After.CreatedAt), filter.After.ID.String()
+	}
+
+	const q = `
+		SELECT ` + auditColumns + `
+		FROM audit_events
+		WHERE tenant = ?8
+		  AND (?1 IS NULL OR actor = ?1)
+		  AND (?2 IS NULL OR faq_id = ?2)
+		  AND (?3 IS NULL OR created_at >= ?3)
+		  AND (?4 IS NULL OR created_at < ?4)
+		  AND (?6 IS NULL OR (created_at, id) < (?5, ?6))
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?7
+	`
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	rows, err := r.db.QueryContext(ctx, q, actor, nullUUID(filter.FAQID), nullTime(filter.From), nullTime(filter.To),
+		cursorTime, cursorID, limit, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list audit events: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.AuditEvent, 0)
+	for rows.Next() {
+		e, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit events: %w", err)
+	}
+	return out, nil
+}
+
+func scanAuditEvent(s rowScanner) (domain.AuditEvent, error) {
+	var (
+		out           domain.AuditEvent
+		idRaw, faqRaw string
+		before, after sql.NullString
+		createdAt     string
+	)
+	if err := s.Scan(&idRaw, &out.Action, &out.Actor, &faqRaw, &out.Locale, &before, &after,
+		&out.RequestID, &out.ClientIP, &createdAt); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("scan audit event: %w", err)
+	}
+	var err error
+	if out.ID, err = uuid.Parse(idRaw); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("parse audit event id: %w", err)
+	}
+	if out.FAQID, err = uuid.Parse(faqRaw); err != nil {
+		return domain.AuditEvent{}, fmt.Errorf("parse audit event faq id: %w", err)
+	}
+	if out.Before, err = decodeSnapshot(before); err != nil {
+		return domain.AuditEvent{}, err
+	}
+	if out.After, err = decodeSnapshot(after); err != nil {
+		return domain.AuditEvent{}, err
+	}
+	if out.CreatedAt, err = parseTime(createdAt); err != nil {
+		return domain.AuditEvent{}, err
+	}
+	return out, nil
+}
+
+// encodeSnapshot returns the JSON of a snapshot, or nil to store NULL.
+func encodeSnapshot(s *domain.FAQSnapshot) (any, error) {
+	if s == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("encode snapshot: %w", err)
+	}
+	return string(raw), nil
+}
+
+func decodeSnapshot(raw sql.NullString) (*domain.FAQSnapshot, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	var s domain.FAQSnapshot
+	if err := json.Unmarshal([]byte(raw.String), &s); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,245 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const categoryColumns = `id, parent_id, name, slug, position, created_at, updated_at`
+
+type CategoryRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewCategoryRepository(db *sql.DB) *CategoryRepository {
+	return &CategoryRepository{db: db, now: time.Now}
+}
+
+func (r *CategoryRepository) List(ctx context.Context) ([]domain.Category, error) {
+	const q = `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE tenant = ?1
+		ORDER BY position ASC, name ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list categories: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.Category, 0)
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate categories: %w", err)
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
+	if err := validateCategoryID(id); err != nil {
+		return domain.Category{}, err
+	}
+	const q = `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE id = ?1 AND tenant = ?2
+	`
+
+	out, err := scanCategory(r.db.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrNotFound
+		}
+		return domain.Category{}, fmt.Errorf("get category: %w", err)
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, in domain.CreateCategoryInput) (domain.Category, error) {
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	const q = `
+		INSERT INTO categories (id, tenant, parent_id, name, slug, position, created_at, updated_at)
+		VALUES (?1, ?7, ?2, ?3, ?4, ?5, ?6, ?6)
+		RETURNING ` + categoryColumns
+
+	out, err := scanCategory(r.db.QueryRowContext(ctx, q, uuid.NewString(), nullUUID(in.ParentID), in.Name, in.Slug,
+		in.Position, formatTime(r.now()), domain.TenantFromContext(ctx)))
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("create category: %w", mapWriteError(err))
+	}
+	return out, nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, id uuid.UUID, in domain.UpdateCategoryInput) (domain.Category, error) {
+	if err := validateCategoryID(id); err != nil {
+		return domain.Category{}, err
+	}
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	const q = `
+		UPDATE categories
+		SET parent_id = ?2, name = ?3, slug = ?4, position = ?5, updated_at = ?6
+		WHERE id = ?1 AND tenant = ?7
+		RETURNING ` + categoryColumns
+
+	out, err := scanCategory(r.db.QueryRowContext(ctx, q, id.String(), nullUUID(in.ParentID), in.Name, in.Slug,
+		in.Position, formatTime(r.now()), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrNotFound
+		}
+		return domain.Category{}, fmt.Errorf("update category: %w", mapWriteError(err))
+	}
+	return out, nil
+}
+
+// Delete removes a category. Its subcategories become top-level and its
+// FAQs move to the end of the FAQs without a category. The foreign keys
+// have no ON DELETE action, so both are detached here.
+func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := validateCategoryID(id); err != nil {
+		return err
+	}
+	const (
+		listQ = `SELECT ` + faqColumns + ` FROM faqs WHERE tenant = ?2 AND category_id = ?1`
+		// positions are parked negative so that the unique position
+		// index holds while the FAQs join the uncategorized group
+		detachQ = `
+			UPDATE faqs
+			SET category_id = NULL, version = version + 1, updated_at = ?2,
+				position = -(position + (
+					SELECT COALESCE(MAX(position), 0) FROM faqs
+					WHERE tenant = ?3 AND category_id IS NULL AND deleted_at IS NULL AND position > 0
+				))
+			WHERE tenant = ?3 AND category_id = ?1
+		`
+		flipQ = `
+			UPDATE faqs
+			SET position = -position
+			WHERE tenant = ?1 AND category_id IS NULL AND position < 0
+			RETURNING ` + faqColumns
+		orphanQ = `UPDATE categories SET parent_id = NULL, updated_at = ?3 WHERE tenant = ?2 AND parent_id = ?1`
+		deleteQ = `DELETE FROM categories WHERE id = ?1 AND tenant = ?2`
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	tenant := domain.TenantFromContext(ctx)
+	now := formatTime(r.now())
+	befores, err := collectFAQs(tx.QueryContext(ctx, listQ, id.String(), tenant))
+	if err != nil {
+		return fmt.Errorf("list faqs: %w", err)
+	}
+	before := make(map[uuid.UUID]domain.FAQ, len(befores))
+	for _, faq := range befores {
+		before[faq.ID] = faq
+	}
+	if _, err := tx.ExecContext(ctx, detachQ, id.String(), now, tenant); err != nil {
+		return fmt.Errorf("detach faqs: %w", err)
+	}
+	detached, err := collectFAQs(tx.QueryContext(ctx, flipQ, tenant))
+	if err != nil {
+		return fmt.Errorf("detach faqs: %w", err)
+	}
+	for _, faq := range detached {
+		if err := insertRevision(ctx, tx, domain.RevisionUpdate, faq); err != nil {
+			return err
+		}
+		prev := before[faq.ID]
+		if err := insertAuditEvent(ctx, tx, faq.UpdatedAt, domain.AuditUpdate, &prev, &faq); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, orphanQ, id.String(), tenant, now); err != nil {
+		return fmt.Errorf("detach subcategories: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, deleteQ, id.String(), tenant)
+	if err != nil {
+		return fmt.Errorf("delete category: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete category: rows affected: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+func scanCategory(s rowScanner) (domain.Category, error) {
+	var (
+		out       domain.Category
+		idRaw     string
+		parentID  sql.NullString
+		createdAt string
+		updatedAt string
+	)
+	err := s.Scan(&idRaw, &parentID, &out.Name, &out.Slug, &out.Position, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("scan category: %w", err)
+	}
+	if out.ID, err = uuid.Parse(idRaw); err != nil {
+		return domain.Category{}, fmt.Errorf("parse category id: %w", err)
+	}
+	if out.ParentID, err = uuidPtr(parentID); err != nil {
+		return domain.Category{}, fmt.Errorf("parse parent id: %w", err)
+	}
+	if out.CreatedAt, err = parseTime(createdAt); err != nil {
+		return domain.Category{}, err
+	}
+	if out.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return domain.Category{}, err
+	}
+	return out, nil
+}
+
+func validateCategoryID(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	return nil
+}
+
+func validateCategoryInput(name, slug string, position int) error {
+	if strings.TrimSpace(name) == "" {
+		return domain.ValidationError{Message: "name is required"}
+	}
+	if strings.TrimSpace(slug) == "" {
+		return domain.ValidationError{Message: "slug is required"}
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
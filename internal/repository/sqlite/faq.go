@@ -0,0 +1,557 @@
+// Package sqlite stores FAQs and categories in an SQLite database. It
+// mirrors the Postgres repositories query by query; the differences come
+// from the column types SQLite lacks, see migrations/sqlite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/textmatch"
+)
+
+const faqColumns = `id, category_id, title, content, content_format, position, is_active,
+	status, draft_title, draft_content, published_at, publish_at, expire_at, deleted_at, version, created_at, updated_at`
+
+// faqPublicCondition selects FAQs whose live content may be served at the
+// moment passed as ?1.
+const faqPublicCondition = `deleted_at IS NULL
+	AND is_active = 1 AND published_at IS NOT NULL AND status <> 'archived'
+	AND (publish_at IS NULL OR publish_at <= ?1)
+	AND (expire_at IS NULL OR expire_at > ?1)`
+
+// timeLayout is the fixed-width form timestamps are stored in, so that
+// comparing them as text compares them as times. The precision matches
+// the one of Postgres.
+const timeLayout = "2006-01-02T15:04:05.000000Z"
+
+type FAQRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewFAQRepository(db *sql.DB) *FAQRepository {
+	return &FAQRepository{db: db, now: time.Now}
+}
+
+func (r *FAQRepository) ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error) {
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE tenant = ?3 AND ` + faqPublicCondition + `
+		  AND (?2 IS NULL OR category_id = ?2)
+		ORDER BY position ASC, created_at ASC, id ASC
+	`
+
+	// read first, so that a change landing in between leaves the time
+	// behind the list rather than ahead of it
+	modified, err := r.lastModified(ctx, filter.At)
+	if err != nil {
+		return domain.ActiveFAQs{}, err
+	}
+
+	out, err := collectFAQs(r.db.QueryContext(ctx, q, formatTime(filter.At), nullUUID(filter.CategoryID),
+		domain.TenantFromContext(ctx)))
+	if err != nil {
+		return domain.ActiveFAQs{}, fmt.Errorf("list active faqs: %w", err)
+	}
+	return domain.ActiveFAQs{Items: out, LastModified: modified}, nil
+}
+
+// lastModified returns when the public FAQs of the tenant last changed as
+// of at: the latest audited change of a FAQ or translation, publish_at or
+// expire_at passed by at, or category update.
+func (r *FAQRepository) lastModified(ctx context.Context, at time.Time) (time.Time, error) {
+	// timestamps share one layout, so they compare as text; the scalar
+	// MAX is NULL if any argument is, hence the empty strings
+	const q = `
+		SELECT MAX(
+			COALESCE((SELECT MAX(created_at) FROM audit_events WHERE tenant = ?2), ''),
+			COALESCE((SELECT MAX(publish_at) FROM faqs WHERE tenant = ?2 AND publish_at <= ?1), ''),
+			COALESCE((SELECT MAX(expire_at) FROM faqs WHERE tenant = ?2 AND expire_at <= ?1), ''),
+			COALESCE((SELECT MAX(updated_at) FROM categories WHERE tenant = ?2), '')
+		)
+	`
+
+	var raw string
+	if err := r.db.QueryRowContext(ctx, q, formatTime(at), domain.TenantFromContext(ctx)).Scan(&raw); err != nil {
+		return time.Time{}, fmt.Errorf("last modified: %w", err)
+	}
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	return parseTime(raw)
+}
+
+func (r *FAQRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE id = ?1 AND tenant = ?2 AND deleted_at IS NULL
+	`
+
+	out, err := scanFAQ(r.db.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("get faq: %w", err)
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) Create(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error) {
+	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	out, err := r.createFAQ(ctx, tx, in)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) Update(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	out, err := r.updateFAQ(ctx, tx, id, in)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// Delete moves a FAQ to the trash. Trashed FAQs are invisible to every
+// other query until restored or purged.
+func (r *FAQRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := validateFAQID(id); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := r.deleteFAQ(ctx, tx, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// createFAQ inserts a FAQ, its first revision and its audit event within
+// tx.
+func (r *FAQRepository) createFAQ(ctx context.Context, tx *sql.Tx, in domain.CreateFAQInput) (domain.FAQ, error) {
+	const q = `
+		INSERT INTO faqs (id, tenant, category_id, title, content, content_format, position, is_active, status, published_at,
+			publish_at, expire_at, created_at, updated_at)
+		VALUES (?1, ?11, ?2, ?3, ?4, COALESCE(NULLIF(?12, ''), 'plain'), ?5, ?6, ?7, CASE WHEN ?7 = 'published' THEN ?10 END,
+			?8, ?9, ?10, ?10)
+		RETURNING ` + faqColumns
+
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, uuid.NewString(), nullUUID(in.CategoryID), in.Title, in.Content,
+		in.Position, in.IsActive, string(in.Status), nullTime(in.PublishAt), nullTime(in.ExpireAt), r.timestamp(),
+		domain.TenantFromContext(ctx), string(in.ContentFormat)))
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("create faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionCreate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, out.UpdatedAt, domain.AuditCreate, nil, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+	return out, nil
+}
+
+// updateFAQ replaces the editable fields of a live FAQ within tx.
+func (r *FAQRepository) updateFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
+	const q = `
+		UPDATE faqs
+		SET category_id = ?2, title = ?3, content = ?4, content_format = COALESCE(NULLIF(?12, ''), content_format),
+			position = ?5, is_active = ?6, publish_at = ?7, expire_at = ?8, version = version + 1, updated_at = ?10
+		WHERE id = ?1 AND tenant = ?11 AND deleted_at IS NULL AND (?9 = 0 OR version = ?9)
+		RETURNING ` + faqColumns
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), nullUUID(in.CategoryID), in.Title, in.Content, in.Position,
+		in.IsActive, nullTime(in.PublishAt), nullTime(in.ExpireAt), in.Version, r.timestamp(),
+		domain.TenantFromContext(ctx), string(in.ContentFormat)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, notUpdated(ctx, tx, id)
+		}
+		return domain.FAQ{}, fmt.Errorf("update faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionUpdate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, out.UpdatedAt, domain.AuditUpdate, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+	return out, nil
+}
+
+// deleteFAQ moves a live FAQ to the trash within tx and returns it.
+func (r *FAQRepository) deleteFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID) (domain.FAQ, error) {
+	const q = `
+		UPDATE faqs
+		SET deleted_at = ?2, version = version + 1, updated_at = ?2
+		WHERE id = ?1
+		RETURNING ` + faqColumns
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	deleted, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), r.timestamp()))
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("delete faq: %w", err)
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionDelete, deleted); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, deleted.UpdatedAt, domain.AuditDelete, &before, nil); err != nil {
+		return domain.FAQ{}, err
+	}
+	return deleted, nil
+}
+
+// lockFAQ returns a live FAQ of the context's tenant. Transactions take
+// the write lock of the database when they begin, so the FAQ stays as read
+// until tx ends.
+func lockFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID) (domain.FAQ, error) {
+	out, err := lockStoredFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if out.DeletedAt != nil {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	return out, nil
+}
+
+// lockStoredFAQ is lockFAQ for FAQs in the trash as well.
+func lockStoredFAQ(ctx context.Context, tx *sql.Tx, id uuid.UUID) (domain.FAQ, error) {
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE id = ?1 AND tenant = ?2
+	`
+
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("get faq: %w", err)
+	}
+	return out, nil
+}
+
+// Search matches the public FAQs word by word with textmatch, as SQLite
+// has no stemming full-text search built in. The language of the query is
+// ignored.
+func (r *FAQRepository) Search(ctx context.Context, in domain.SearchQuery) ([]domain.SearchResult, error) {
+	query := textmatch.Parse(in.Query)
+	if query.Empty() {
+		return []domain.SearchResult{}, nil
+	}
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE tenant = ?3 AND ` + faqPublicCondition + `
+		  AND (?2 IS NULL OR category_id = ?2)
+		ORDER BY position ASC, created_at ASC, id ASC
+	`
+
+	items, err := collectFAQs(r.db.QueryContext(ctx, q, formatTime(in.At), nullUUID(in.CategoryID),
+		domain.TenantFromContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("search faqs: %w", err)
+	}
+
+	out := make([]domain.SearchResult, 0)
+	for _, faq := range items {
+		rank, ok := query.Rank(faq.Title, faq.Content)
+		if !ok {
+			continue
+		}
+		out = append(out, domain.SearchResult{
+			FAQ:              faq,
+			Rank:             rank,
+			TitleHighlight:   query.Highlight(faq.Title),
+			ContentHighlight: query.Highlight(faq.Content),
+		})
+	}
+	// rows are already ordered by position, which breaks rank ties
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Rank > out[j].Rank
+	})
+	if in.Limit > 0 && len(out) > in.Limit {
+		out = out[:in.Limit]
+	}
+	return out, nil
+}
+
+// notUpdated explains why a versioned update matched no row: the FAQ is
+// gone or it has been changed since the expected version.
+func notUpdated(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	const q = `SELECT version FROM faqs WHERE id = ?1 AND tenant = ?2 AND deleted_at IS NULL`
+
+	var version int
+	if err := tx.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get faq version: %w", err)
+	}
+	return domain.VersionConflictError{Current: version}
+}
+
+// timestamp returns the current time in the stored form. It stands in for
+// now() of Postgres.
+func (r *FAQRepository) timestamp() string {
+	return formatTime(r.now())
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanFAQ scans faqColumns followed by any extra selected columns.
+func scanFAQ(s rowScanner, extra ...any) (domain.FAQ, error) {
+	var (
+		out          domain.FAQ
+		idRaw        string
+		categoryID   sql.NullString
+		format       string
+		status       string
+		draftTitle   sql.NullString
+		draftContent sql.NullString
+		publishedAt  sql.NullString
+		publishAt    sql.NullString
+		expireAt     sql.NullString
+		deletedAt    sql.NullString
+		createdAt    string
+		updatedAt    string
+	)
+	dest := []any{&idRaw, &categoryID, &out.Title, &out.Content, &format, &out.Position, &out.IsActive,
+		&status, &draftTitle, &draftContent, &publishedAt, &publishAt, &expireAt, &deletedAt, &out.Version, &createdAt, &updatedAt}
+	if err := s.Scan(append(dest, extra...)...); err != nil {
+		return domain.FAQ{}, fmt.Errorf("scan faq: %w", err)
+	}
+
+	var err error
+	if out.ID, err = uuid.Parse(idRaw); err != nil {
+		return domain.FAQ{}, fmt.Errorf("parse faq id: %w", err)
+	}
+	if out.CategoryID, err = uuidPtr(categoryID); err != nil {
+		return domain.FAQ{}, fmt.Errorf("parse category id: %w", err)
+	}
+	out.ContentFormat = domain.ContentFormat(format)
+	out.Status = domain.FAQStatus(status)
+	if draftTitle.Valid && draftContent.Valid {
+		out.Draft = &domain.FAQDraft{Title: draftTitle.String, Content: draftContent.String}
+	}
+	for _, t := range []struct {
+		raw sql.NullString
+		dst **time.Time
+	}{
+		{publishedAt, &out.PublishedAt},
+		{publishAt, &out.PublishAt},
+		{expireAt, &out.ExpireAt},
+		{deletedAt, &out.DeletedAt},
+	} {
+		if *t.dst, err = timePtr(t.raw); err != nil {
+			return domain.FAQ{}, err
+		}
+	}
+	if out.CreatedAt, err = parseTime(createdAt); err != nil {
+		return domain.FAQ{}, err
+	}
+	if out.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return domain.FAQ{}, err
+	}
+	return out, nil
+}
+
+// collectFAQs reads all rows of a faqColumns query and closes them, so the
+// transaction can be used again afterwards.
+func collectFAQs(rows *sql.Rows, err error) ([]domain.FAQ, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]domain.FAQ, 0)
+	for rows.Next() {
+		faq, err := scanFAQ(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, faq)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate faqs: %w", err)
+	}
+	return out, nil
+}
+
+// mapWriteError turns constraint violations into domain errors.
+func mapWriteError(err error) error {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		msg := sqliteErr.Error()
+		switch sqliteErr.ExtendedCode {
+		case sqlite3.ErrConstraintForeignKey:
+			// faqs and categories only reference categories
+			return domain.ValidationError{Message: "category not found"}
+		case sqlite3.ErrConstraintCheck:
+			if strings.Contains(msg, "schedule") {
+				return domain.ValidationError{Message: "expire_at must be after publish_at"}
+			}
+			if strings.Contains(msg, "content_format") {
+				return domain.ValidationError{Message: "content_format must be plain, markdown or html"}
+			}
+		case sqlite3.ErrConstraintUnique:
+			if strings.Contains(msg, "slug") {
+				return domain.ValidationError{Message: "slug already exists"}
+			}
+			if strings.Contains(msg, "position") {
+				return domain.ValidationError{Message: "position is already taken in the category"}
+			}
+		}
+	}
+	return err
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+func parseTime(s string) (time.Time, error) {
+	t, err := time.Parse(timeLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time: %w", err)
+	}
+	return t, nil
+}
+
+func nullTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return formatTime(*t)
+}
+
+func timePtr(s sql.NullString) (*time.Time, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	t, err := parseTime(s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func nullUUID(id *uuid.UUID) any {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
+
+func uuidPtr(s sql.NullString) (*uuid.UUID, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	id, err := uuid.Parse(s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func sameCategory(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func validateFAQID(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	return nil
+}
+
+func validateFAQInput(title, content string, position int) error {
+	if err := validateContent(title, content); err != nil {
+		return err
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
+
+func validateContent(title, content string) error {
+	if strings.TrimSpace(title) == "" {
+		return domain.ValidationError{Message: "title is required"}
+	}
+	if strings.TrimSpace(content) == "" {
+		return domain.ValidationError{Message: "content is required"}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Import applies an import in one transaction. Each row runs under a
+// savepoint, so a row breaking a constraint is undone and reported while
+// the remaining rows are still checked. The transaction is committed only
+// when no row failed and it is not a dry run.
+func (r *FAQRepository) Import(ctx context.Context, in domain.ImportInput) (domain.ImportResult, error) {
+	const (
+		liveQ = `
+			SELECT ` + faqColumns + `
+			FROM faqs
+			WHERE tenant = ?1 AND deleted_at IS NULL
+			ORDER BY position ASC, created_at ASC, id ASC
+		`
+		trashQ = `
+			UPDATE faqs
+			SET deleted_at = ?2, version = version + 1, updated_at = ?2
+			WHERE id = ?1
+			RETURNING ` + faqColumns
+		flipQ = `UPDATE faqs SET position = -position WHERE id = ?1`
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.ImportResult{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	live, err := collectFAQs(tx.QueryContext(ctx, liveQ, domain.TenantFromContext(ctx)))
+	if err != nil {
+		return domain.ImportResult{}, fmt.Errorf("list faqs: %w", err)
+	}
+	current := make(map[uuid.UUID]domain.FAQ, len(live))
+	for _, f := range live {
+		current[f.ID] = f
+	}
+	listed := make(map[uuid.UUID]struct{}, len(in.Items))
+	for _, it := range in.Items {
+		if it.ID != nil {
+			listed[*it.ID] = struct{}{}
+		}
+	}
+
+	var result domain.ImportResult
+	if in.Strategy == domain.ImportReplace {
+		now := r.timestamp()
+		for _, f := range live {
+			if _, ok := listed[f.ID]; ok {
+				continue
+			}
+			trashed, err := scanFAQ(tx.QueryRowContext(ctx, trashQ, f.ID.String(), now))
+			if err != nil {
+				return domain.ImportResult{}, fmt.Errorf("delete faq: %w", err)
+			}
+			if err := insertRevision(ctx, tx, domain.RevisionDelete, trashed); err != nil {
+				return domain.ImportResult{}, err
+			}
+			before := f
+			if err := insertAuditEvent(ctx, tx, trashed.UpdatedAt, domain.AuditDelete, &before, nil); err != nil {
+				return domain.ImportResult{}, err
+			}
+			result.Changes = append(result.Changes, domain.ImportChange{Action: domain.AuditDelete, Before: &before})
+		}
+	}
+
+	// FAQs changing place are moved out of the way first, so that rows can
+	// swap positions without hitting the unique index
+	for _, it := range in.Items {
+		if it.ID == nil {
+			continue
+		}
+		if f, ok := current[*it.ID]; ok && (f.Position != it.Position || !sameCategory(f.CategoryID, it.CategoryID)) {
+			if _, err := tx.ExecContext(ctx, flipQ, f.ID.String()); err != nil {
+				return domain.ImportResult{}, fmt.Errorf("move faqs: %w", err)
+			}
+		}
+	}
+
+	for _, it := range in.Items {
+		if it.ID != nil {
+			if f, ok := current[*it.ID]; ok && it.Unchanged(f) {
+				result.Unchanged++
+				continue
+			}
+		}
+		if _, err := tx.ExecContext(ctx, `SAVEPOINT import_row`); err != nil {
+			return domain.ImportResult{}, fmt.Errorf("savepoint: %w", err)
+		}
+		change, err := r.importRow(ctx, tx, current, it)
+		if err != nil {
+			var ve domain.ValidationError
+			if !errors.As(err, &ve) {
+				return domain.ImportResult{}, err
+			}
+			if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT import_row`); err != nil {
+				return domain.ImportResult{}, fmt.Errorf("rollback to savepoint: %w", err)
+			}
+			result.Errors = append(result.Errors, domain.ImportError{Row: it.Row, Message: ve.Message})
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT import_row`); err != nil {
+			return domain.ImportResult{}, fmt.Errorf("release savepoint: %w", err)
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	if len(result.Errors) > 0 || in.DryRun {
+		return result, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.ImportResult{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return result, nil
+}
+
+// importRow updates the live FAQ an item refers to or creates a new one.
+// An ID that is unknown to the tenant's live FAQs must not be in use at
+// all, since IDs are unique across tenants and the trash.
+func (r *FAQRepository) importRow(ctx context.Context, tx *sql.Tx, current map[uuid.UUID]domain.FAQ, it domain.ImportItem) (domain.ImportChange, error) {
+	const (
+		ownerQ  = `SELECT tenant = ?2, deleted_at IS NOT NULL FROM faqs WHERE id = ?1`
+		insertQ = `
+			INSERT INTO faqs (id, tenant, category_id, title, content, content_format, position, is_active, status,
+				published_at, publish_at, expire_at, created_at, updated_at)
+			VALUES (?1, ?11, ?2, ?3, ?4, COALESCE(NULLIF(?12, ''), 'plain'), ?5, ?6, ?7, CASE WHEN ?7 = 'published' THEN ?10 END,
+				?8, ?9, ?10, ?10)
+			RETURNING ` + faqColumns
+		updateQ = `
+			UPDATE faqs
+			SET category_id = ?2, title = ?3, content = ?4, content_format = COALESCE(NULLIF(?12, ''), content_format),
+				position = ?5, is_active = ?6, status = ?7,
+				published_at = CASE WHEN ?7 = 'archived' THEN NULL
+					ELSE COALESCE(published_at, CASE WHEN ?7 = 'published' THEN ?10 END) END,
+				publish_at = ?8, expire_at = ?9, version = version + 1, updated_at = ?10
+			WHERE id = ?1 AND tenant = ?11
+			RETURNING ` + faqColumns
+	)
+	if err := validateFAQInput(it.Title, it.Content, it.Position); err != nil {
+		return domain.ImportChange{}, err
+	}
+	tenant := domain.TenantFromContext(ctx)
+	args := []any{nullUUID(it.CategoryID), it.Title, it.Content, it.Position, it.IsActive, string(it.Status),
+		nullTime(it.PublishAt), nullTime(it.ExpireAt), r.timestamp(), tenant, string(it.ContentFormat)}
+
+	if it.ID != nil {
+		if before, ok := current[*it.ID]; ok {
+			after, err := scanFAQ(tx.QueryRowContext(ctx, updateQ, append([]any{it.ID.String()}, args...)...))
+			if err != nil {
+				return domain.ImportChange{}, fmt.Errorf("update faq: %w", mapWriteError(err))
+			}
+			if err := insertRevision(ctx, tx, domain.RevisionUpdate, after); err != nil {
+				return domain.ImportChange{}, err
+			}
+			if err := insertAuditEvent(ctx, tx, after.UpdatedAt, domain.AuditUpdate, &before, &after); err != nil {
+				return domain.ImportChange{}, err
+			}
+			return domain.ImportChange{Row: it.Row, Action: domain.AuditUpdate, Before: &before, After: &after}, nil
+		}
+
+		var ownTenant, trashed bool
+		err := tx.QueryRowContext(ctx, ownerQ, it.ID.String(), tenant).Scan(&ownTenant, &trashed)
+		switch {
+		case err == nil && ownTenant && trashed:
+			return domain.ImportChange{}, domain.ValidationError{Message: "faq is in the trash, restore it first"}
+		case err == nil:
+			return domain.ImportChange{}, domain.ValidationError{Message: "id is already taken"}
+		case !errors.Is(err, sql.ErrNoRows):
+			return domain.ImportChange{}, fmt.Errorf("get faq owner: %w", err)
+		}
+	}
+
+	id := uuid.New()
+	if it.ID != nil {
+		id = *it.ID
+	}
+	created, err := scanFAQ(tx.QueryRowContext(ctx, insertQ, append([]any{id.String()}, args...)...))
+	if err != nil {
+		return domain.ImportChange{}, fmt.Errorf("create faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionCreate, created); err != nil {
+		return domain.ImportChange{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, created.UpdatedAt, domain.AuditCreate, nil, &created); err != nil {
+		return domain.ImportChange{}, err
+	}
+	return domain.ImportChange{Row: it.Row, Action: domain.AuditCreate, After: &created}, nil
+}
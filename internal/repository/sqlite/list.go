@@ -0,0 +1,108 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// listSortColumns maps sort keys to their columns.
+var listSortColumns = map[domain.FAQSortKey]string{
+	domain.SortByPosition:  "position",
+	domain.SortByCreatedAt: "created_at",
+	domain.SortByUpdatedAt: "updated_at",
+	domain.SortByTitle:     "title",
+}
+
+// List returns FAQs for the admin list regardless of their status and
+// schedule. Trashed FAQs are excluded. Rows are ordered by the sort key with
+// the ID as a tie-breaker, so the list can be continued from a cursor.
+// Titles are compared byte by byte and the query matches case-insensitively
+// only for ASCII letters.
+func (r *FAQRepository) List(ctx context.Context, filter domain.FAQListFilter) ([]domain.FAQ, error) {
+	column, ok := listSortColumns[filter.Sort]
+	if !ok {
+		return nil, domain.ValidationError{Message: "sort is invalid"}
+	}
+	dir, cmp := "ASC", ">"
+	if filter.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	var status, query, cursorValue, cursorID any
+	if filter.Status != "" {
+		status = string(filter.Status)
+	}
+	if filter.Query != "" {
+		query = "%" + escapeLike(filter.Query) + "%"
+	}
+	if filter.After != nil {
+		value, err := cursorArg(*filter.After)
+		if err != nil {
+			return nil, err
+		}
+		cursorValue, cursorID = value, filter.After.ID.String()
+	}
+
+	q := `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE tenant = ?12 AND deleted_at IS NULL
+		  AND (?1 IS NULL OR is_active = ?1)
+		  AND (?2 IS NULL OR status = ?2)
+		  AND (?3 IS NULL OR category_id = ?3)
+		  AND (?4 IS NULL OR created_at >= ?4)
+		  AND (?5 IS NULL OR created_at < ?5)
+		  AND (?6 IS NULL OR updated_at >= ?6)
+		  AND (?7 IS NULL OR updated_at < ?7)
+		  AND (?8 IS NULL OR title LIKE ?8 ESCAPE '\' OR content LIKE ?8 ESCAPE '\')
+		  AND (?10 IS NULL OR (` + column + `, id) ` + cmp + ` (?9, ?10))
+		ORDER BY ` + column + ` ` + dir + `, id ` + dir + `
+		LIMIT ?11
+	`
+
+	var isActive any
+	if filter.IsActive != nil {
+		isActive = *filter.IsActive
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+	out, err := collectFAQs(r.db.QueryContext(ctx, q, isActive, status, nullUUID(filter.CategoryID),
+		nullTime(filter.CreatedFrom), nullTime(filter.CreatedTo), nullTime(filter.UpdatedFrom), nullTime(filter.UpdatedTo),
+		query, cursorValue, cursorID, limit, domain.TenantFromContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("list faqs: %w", err)
+	}
+	return out, nil
+}
+
+// cursorArg converts the cursor value to the stored form of its column.
+func cursorArg(c domain.FAQCursor) (any, error) {
+	switch c.Sort {
+	case domain.SortByPosition:
+		position, err := strconv.Atoi(c.Value)
+		if err != nil {
+			return nil, domain.ValidationError{Message: "cursor is invalid"}
+		}
+		return position, nil
+	case domain.SortByCreatedAt, domain.SortByUpdatedAt:
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, domain.ValidationError{Message: "cursor is invalid"}
+		}
+		return formatTime(t), nil
+	}
+	return c.Value, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,208 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Reorder rewrites the positions of all FAQs in a category to 1..n in the
+// order of in.IDs, which must list every FAQ of the category exactly once.
+func (r *FAQRepository) Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, positions, err := categoryPositions(ctx, tx, in.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) != len(in.IDs) {
+		return nil, domain.ValidationError{Message: "ids must list every faq of the category exactly once"}
+	}
+	for _, id := range in.IDs {
+		if _, ok := positions[id]; !ok {
+			return nil, domain.ValidationError{Message: "ids must list every faq of the category exactly once"}
+		}
+	}
+
+	out, err := r.writePositions(ctx, tx, in.CategoryID, positions, in.IDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// Move places a FAQ right before or after another FAQ of its category and
+// renumbers the category.
+func (r *FAQRepository) Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error) {
+	if err := validateFAQID(in.ID); err != nil {
+		return nil, err
+	}
+	const categoryQ = `SELECT category_id FROM faqs WHERE id = ?1 AND tenant = ?2 AND deleted_at IS NULL`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var rawCategoryID sql.NullString
+	if err := tx.QueryRowContext(ctx, categoryQ, in.ID.String(), domain.TenantFromContext(ctx)).Scan(&rawCategoryID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get faq category: %w", err)
+	}
+	categoryID, err := uuidPtr(rawCategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("parse category id: %w", err)
+	}
+
+	order, positions, err := categoryPositions(ctx, tx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := positions[in.Target]; !ok {
+		return nil, domain.ValidationError{Message: "target faq not found in the same category"}
+	}
+
+	ids := make([]uuid.UUID, 0, len(order))
+	for _, id := range order {
+		if id == in.ID {
+			continue
+		}
+		if id == in.Target && !in.After {
+			ids = append(ids, in.ID)
+		}
+		ids = append(ids, id)
+		if id == in.Target && in.After {
+			ids = append(ids, in.ID)
+		}
+	}
+
+	out, err := r.writePositions(ctx, tx, categoryID, positions, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// categoryPositions returns the IDs of the live FAQs of a category in the
+// current order together with their positions. Transactions hold the
+// database write lock from the start, so nothing changes them meanwhile.
+func categoryPositions(ctx context.Context, tx *sql.Tx, categoryID *uuid.UUID) ([]uuid.UUID, map[uuid.UUID]int, error) {
+	const q = `
+		SELECT id, position
+		FROM faqs
+		WHERE tenant = ?2 AND deleted_at IS NULL AND category_id IS ?1
+		ORDER BY position ASC, created_at ASC
+	`
+
+	rows, err := tx.QueryContext(ctx, q, nullUUID(categoryID), domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, nil, fmt.Errorf("list category: %w", err)
+	}
+	defer rows.Close()
+
+	order := make([]uuid.UUID, 0)
+	positions := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			idRaw    string
+			position int
+		)
+		if err := rows.Scan(&idRaw, &position); err != nil {
+			return nil, nil, fmt.Errorf("scan position: %w", err)
+		}
+		id, err := uuid.Parse(idRaw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse faq id: %w", err)
+		}
+		order = append(order, id)
+		positions[id] = position
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("iterate positions: %w", err)
+	}
+	return order, positions, nil
+}
+
+// writePositions assigns position i+1 to ids[i], writing a revision and an
+// audit event for every FAQ that actually moved, and returns the category
+// in its new order.
+// Moved rows are first flipped to negative positions so that the unique
+// position index is never violated halfway through. ids all come from
+// categoryPositions, so they belong to the tenant.
+func (r *FAQRepository) writePositions(ctx context.Context, tx *sql.Tx, categoryID *uuid.UUID, current map[uuid.UUID]int, ids []uuid.UUID) ([]domain.FAQ, error) {
+	const (
+		flipQ = `UPDATE faqs SET position = -position WHERE id = ?1`
+		setQ  = `
+			UPDATE faqs
+			SET position = ?2, version = version + 1, updated_at = ?3
+			WHERE id = ?1
+			RETURNING ` + faqColumns
+		listQ = `
+			SELECT ` + faqColumns + `
+			FROM faqs
+			WHERE tenant = ?2 AND deleted_at IS NULL AND category_id IS ?1
+			ORDER BY position ASC
+		`
+	)
+
+	moved := make([]uuid.UUID, 0)
+	targets := make([]int, 0)
+	for i, id := range ids {
+		if current[id] != i+1 {
+			moved = append(moved, id)
+			targets = append(targets, i+1)
+		}
+	}
+
+	before := make([]domain.FAQ, 0, len(moved))
+	for _, id := range moved {
+		faq, err := lockFAQ(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		before = append(before, faq)
+		if _, err := tx.ExecContext(ctx, flipQ, id.String()); err != nil {
+			return nil, fmt.Errorf("reorder faqs: %w", err)
+		}
+	}
+	now := r.timestamp()
+	for i, id := range moved {
+		faq, err := scanFAQ(tx.QueryRowContext(ctx, setQ, id.String(), targets[i], now))
+		if err != nil {
+			return nil, fmt.Errorf("reorder faqs: %w", err)
+		}
+		if err := insertRevision(ctx, tx, domain.RevisionUpdate, faq); err != nil {
+			return nil, err
+		}
+		if err := insertAuditEvent(ctx, tx, faq.UpdatedAt, domain.AuditUpdate, &before[i], &faq); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := collectFAQs(tx.QueryContext(ctx, listQ, nullUUID(categoryID), domain.TenantFromContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("list category: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Patch changes only the fields set in the patch.
+func (r *FAQRepository) Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	const q = `
+		UPDATE faqs
+		SET category_id = CASE WHEN ?2 THEN ?3 ELSE category_id END,
+			title = CASE WHEN ?4 THEN ?5 ELSE title END,
+			content = CASE WHEN ?6 THEN ?7 ELSE content END,
+			content_format = CASE WHEN ?19 THEN ?20 ELSE content_format END,
+			position = CASE WHEN ?8 THEN ?9 ELSE position END,
+			is_active = CASE WHEN ?10 THEN ?11 ELSE is_active END,
+			publish_at = CASE WHEN ?12 THEN ?13 ELSE publish_at END,
+			expire_at = CASE WHEN ?14 THEN ?15 ELSE expire_at END,
+			version = version + 1,
+			updated_at = ?17
+		WHERE id = ?1 AND tenant = ?18 AND deleted_at IS NULL AND (?16 = 0 OR version = ?16)
+		RETURNING ` + faqColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(),
+		patch.CategoryID.Set, nullUUID(patch.CategoryID.Value),
+		patch.Title.Set, patch.Title.Value,
+		patch.Content.Set, patch.Content.Value,
+		patch.Position.Set, patch.Position.Value,
+		patch.IsActive.Set, patch.IsActive.Value,
+		patch.PublishAt.Set, nullTime(patch.PublishAt.Value),
+		patch.ExpireAt.Set, nullTime(patch.ExpireAt.Value),
+		patch.Version, r.timestamp(),
+		domain.TenantFromContext(ctx),
+		patch.ContentFormat.Set, string(patch.ContentFormat.Value),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, notUpdated(ctx, tx, id)
+		}
+		return domain.FAQ{}, fmt.Errorf("patch faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionUpdate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, out.UpdatedAt, domain.AuditUpdate, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,224 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const revisionColumns = `faq_id, revision, action, actor, snapshot, created_at`
+
+func (r *FAQRepository) ListRevisions(ctx context.Context, faqID uuid.UUID) ([]domain.Revision, error) {
+	if err := validateFAQID(faqID); err != nil {
+		return nil, err
+	}
+	const q = `
+		SELECT ` + revisionColumns + `
+		FROM faq_revisions
+		WHERE faq_id = ?1 AND tenant = ?2
+		ORDER BY revision DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, faqID.String(), domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.Revision, 0)
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate revisions: %w", err)
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) GetRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.Revision, error) {
+	if err := validateFAQID(faqID); err != nil {
+		return domain.Revision{}, err
+	}
+	const q = `
+		SELECT ` + revisionColumns + `
+		FROM faq_revisions
+		WHERE faq_id = ?1 AND revision = ?2 AND tenant = ?3
+	`
+
+	out, err := scanRevision(r.db.QueryRowContext(ctx, q, faqID.String(), number, domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Revision{}, domain.ErrNotFound
+		}
+		return domain.Revision{}, fmt.Errorf("get revision: %w", err)
+	}
+	return out, nil
+}
+
+// RestoreRevision makes the snapshot of a revision the current version of
+// the FAQ, taking it out of the trash or recreating it if it has been
+// purged since. A FAQ ID taken by another tenant is never overwritten.
+func (r *FAQRepository) RestoreRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.FAQ, error) {
+	if err := validateFAQID(faqID); err != nil {
+		return domain.FAQ{}, err
+	}
+	const (
+		getQ = `
+			SELECT snapshot
+			FROM faq_revisions
+			WHERE faq_id = ?1 AND revision = ?2 AND tenant = ?3
+		`
+		upsertQ = `
+			INSERT INTO faqs (id, tenant, category_id, title, content, content_format, position, is_active,
+				status, draft_title, draft_content, published_at, publish_at, expire_at, created_at, updated_at)
+			VALUES (?1, ?14, ?2, ?3, ?4, ?15, ?5, ?6, ?7, ?8, ?9, CASE WHEN ?7 = 'published' THEN ?13 END, ?10, ?11, ?12, ?13)
+			ON CONFLICT (id) DO UPDATE
+			SET category_id = excluded.category_id,
+				title = excluded.title,
+				content = excluded.content,
+				content_format = excluded.content_format,
+				position = excluded.position,
+				is_active = excluded.is_active,
+				status = excluded.status,
+				draft_title = excluded.draft_title,
+				draft_content = excluded.draft_content,
+				published_at = CASE WHEN excluded.status = 'archived' THEN NULL
+					ELSE COALESCE(faqs.published_at, excluded.published_at) END,
+				publish_at = excluded.publish_at,
+				expire_at = excluded.expire_at,
+				deleted_at = NULL,
+				version = faqs.version + 1,
+				updated_at = excluded.updated_at
+			WHERE faqs.tenant = excluded.tenant
+			RETURNING ` + faqColumns
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	tenant := domain.TenantFromContext(ctx)
+	var raw string
+	if err := tx.QueryRowContext(ctx, getQ, faqID.String(), number, tenant).Scan(&raw); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("get revision: %w", err)
+	}
+	var snap domain.FAQSnapshot
+	if err := json.Unmarshal([]byte(raw), &snap); err != nil {
+		return domain.FAQ{}, fmt.Errorf("decode snapshot: %w", err)
+	}
+
+	// revisions written before the workflow existed have no status
+	if !snap.Status.Valid() {
+		snap.Status = domain.StatusPublished
+	}
+	// nor a content format before formats existed
+	if !snap.ContentFormat.Valid() {
+		snap.ContentFormat = domain.ContentPlain
+	}
+	// the FAQ may be live, in the trash or purged
+	var before *domain.FAQ
+	current, err := lockStoredFAQ(ctx, tx, faqID)
+	switch {
+	case err == nil:
+		before = &current
+	case !errors.Is(err, domain.ErrNotFound):
+		return domain.FAQ{}, err
+	}
+
+	draftTitle, draftContent := draftArgs(snap.Draft)
+	out, err := scanFAQ(tx.QueryRowContext(ctx, upsertQ, faqID.String(), nullUUID(snap.CategoryID), snap.Title,
+		snap.Content, snap.Position, snap.IsActive, string(snap.Status), draftTitle, draftContent,
+		nullTime(snap.PublishAt), nullTime(snap.ExpireAt), formatTime(snap.CreatedAt), r.timestamp(), tenant,
+		string(snap.ContentFormat)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("restore faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionRestore, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, out.UpdatedAt, restoreAction(before), before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// restoreAction is the audit action of restoring a revision over the FAQ
+// as it was: a live FAQ only changes, one from the trash or a purged one
+// comes back.
+func restoreAction(before *domain.FAQ) domain.AuditAction {
+	if before != nil && before.DeletedAt == nil {
+		return domain.AuditUpdate
+	}
+	return domain.AuditRestore
+}
+
+// insertRevision appends the next revision of a FAQ. It must run in the
+// transaction that changed the FAQ; the revision is dated with the
+// FAQ's update time, which that transaction has just set.
+func insertRevision(ctx context.Context, tx *sql.Tx, action domain.RevisionAction, faq domain.FAQ) error {
+	const q = `
+		INSERT INTO faq_revisions (tenant, faq_id, revision, action, actor, snapshot, created_at)
+		SELECT ?6, ?1, COALESCE(MAX(revision), 0) + 1, ?2, ?3, ?4, ?5
+		FROM faq_revisions
+		WHERE faq_id = ?1
+	`
+
+	snapshot, err := json.Marshal(domain.NewFAQSnapshot(faq))
+	if err != nil {
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, q, faq.ID.String(), string(action), domain.ActorFromContext(ctx),
+		string(snapshot), formatTime(faq.UpdatedAt), domain.TenantFromContext(ctx)); err != nil {
+		return fmt.Errorf("insert revision: %w", err)
+	}
+	return nil
+}
+
+func scanRevision(s rowScanner) (domain.Revision, error) {
+	var (
+		out       domain.Revision
+		idRaw     string
+		action    string
+		raw       string
+		createdAt string
+	)
+	if err := s.Scan(&idRaw, &out.Number, &action, &out.Actor, &raw, &createdAt); err != nil {
+		return domain.Revision{}, fmt.Errorf("scan revision: %w", err)
+	}
+	id, err := uuid.Parse(idRaw)
+	if err != nil {
+		return domain.Revision{}, fmt.Errorf("parse faq id: %w", err)
+	}
+	if err := json.Unmarshal([]byte(raw), &out.Snapshot); err != nil {
+		return domain.Revision{}, fmt.Errorf("decode snapshot: %w", err)
+	}
+	if out.CreatedAt, err = parseTime(createdAt); err != nil {
+		return domain.Revision{}, err
+	}
+	out.FAQID = id
+	out.Action = domain.RevisionAction(action)
+	return out, nil
+}
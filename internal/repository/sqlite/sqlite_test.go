@@ -0,0 +1,44 @@
+package sqlite_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/nightmaker00/accordion-go/internal/repository/repotest"
+	"github.com/nightmaker00/accordion-go/internal/repository/sqlite"
+	"github.com/nightmaker00/accordion-go/migrations"
+	"github.com/nightmaker00/accordion-go/pkg/db/migrate"
+	db "github.com/nightmaker00/accordion-go/pkg/db/sqlite"
+)
+
+func TestConformance(t *testing.T) {
+	scripts, err := fs.Sub(migrations.SQLite, "sqlite")
+	if err != nil {
+		t.Fatalf("migrations: %v", err)
+	}
+
+	repotest.Run(t, func(t *testing.T) repotest.Repos {
+		conn, err := db.Open(db.Config{Path: filepath.Join(t.TempDir(), "faq.db")})
+		if err != nil {
+			t.Fatalf("open db: %v", err)
+		}
+		t.Cleanup(func() {
+			_ = conn.Close()
+		})
+		migrator, err := migrate.New(conn, migrate.SQLite, scripts)
+		if err != nil {
+			t.Fatalf("load migrations: %v", err)
+		}
+		if err := migrator.Up(context.Background()); err != nil {
+			t.Fatalf("migrate: %v", err)
+		}
+		return repotest.Repos{
+			FAQs:       sqlite.NewFAQRepository(conn),
+			Categories: sqlite.NewCategoryRepository(conn),
+			APIKeys:    sqlite.NewAPIKeyRepository(conn),
+			Audit:      sqlite.NewAuditRepository(conn),
+		}
+	})
+}
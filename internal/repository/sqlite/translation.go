@@ -0,0 +1,233 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const translationColumns = `faq_id, locale, title, content, created_at, updated_at`
+
+// translationTenantCondition limits translations to the FAQs of the tenant
+// passed as the given parameter; translations have no tenant of their own.
+func translationTenantCondition(param string) string {
+	return `EXISTS (SELECT 1 FROM faqs f WHERE f.id = faq_id AND f.tenant = ` + param + `)`
+}
+
+func (r *FAQRepository) ListTranslations(ctx context.Context, faqID uuid.UUID) ([]domain.Translation, error) {
+	if err := validateFAQID(faqID); err != nil {
+		return nil, err
+	}
+	q := `
+		SELECT ` + translationColumns + `
+		FROM faq_translations
+		WHERE faq_id = ?1 AND ` + translationTenantCondition("?2") + `
+		ORDER BY locale ASC
+	`
+	return r.queryTranslations(ctx, q, faqID.String(), domain.TenantFromContext(ctx))
+}
+
+func (r *FAQRepository) FindTranslations(ctx context.Context, faqIDs []uuid.UUID, locales []string) ([]domain.Translation, error) {
+	if len(faqIDs) == 0 || len(locales) == 0 {
+		return []domain.Translation{}, nil
+	}
+
+	args := make([]any, 0, len(faqIDs)+len(locales)+1)
+	for _, id := range faqIDs {
+		args = append(args, id.String())
+	}
+	for _, locale := range locales {
+		args = append(args, locale)
+	}
+	args = append(args, domain.TenantFromContext(ctx))
+	q := `
+		SELECT ` + translationColumns + `
+		FROM faq_translations
+		WHERE faq_id IN (` + placeholders(len(faqIDs)) + `)
+		  AND locale IN (` + placeholders(len(locales)) + `)
+		  AND ` + translationTenantCondition("?") + `
+	`
+	return r.queryTranslations(ctx, q, args...)
+}
+
+func (r *FAQRepository) UpsertTranslation(ctx context.Context, in domain.UpsertTranslationInput) (domain.Translation, error) {
+	if err := validateFAQID(in.FAQID); err != nil {
+		return domain.Translation{}, err
+	}
+	if err := validateTranslationInput(in.Locale, in.Title, in.Content); err != nil {
+		return domain.Translation{}, err
+	}
+	const q = `
+		INSERT INTO faq_translations (faq_id, locale, title, content, created_at, updated_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?5)
+		ON CONFLICT (faq_id, locale) DO UPDATE
+		SET title = excluded.title, content = excluded.content, updated_at = excluded.updated_at
+		RETURNING ` + translationColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Translation{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	faq, err := lockFAQ(ctx, tx, in.FAQID)
+	if err != nil {
+		return domain.Translation{}, err
+	}
+	before, err := findTranslation(ctx, tx, in.FAQID, in.Locale)
+	if err != nil {
+		return domain.Translation{}, err
+	}
+	out, err := scanTranslation(tx.QueryRowContext(ctx, q, in.FAQID.String(), in.Locale, in.Title, in.Content, r.timestamp()))
+	if err != nil {
+		return domain.Translation{}, fmt.Errorf("upsert translation: %w", err)
+	}
+	event := domain.NewTranslationAuditEventInput(ctx, domain.AuditCreate, faq, in.Locale, nil, &out)
+	if before != nil {
+		event = domain.NewTranslationAuditEventInput(ctx, domain.AuditUpdate, faq, in.Locale, before, &out)
+	}
+	if _, err := appendAuditEvent(ctx, tx, event, out.UpdatedAt); err != nil {
+		return domain.Translation{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Translation{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) DeleteTranslation(ctx context.Context, faqID uuid.UUID, locale string) error {
+	if err := validateFAQID(faqID); err != nil {
+		return err
+	}
+	const q = `DELETE FROM faq_translations WHERE faq_id = ?1 AND locale = ?2`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	faq, err := lockStoredFAQ(ctx, tx, faqID)
+	if err != nil {
+		return err
+	}
+	before, err := findTranslation(ctx, tx, faqID, locale)
+	if err != nil {
+		return err
+	}
+	if before == nil {
+		return domain.ErrNotFound
+	}
+	if _, err := tx.ExecContext(ctx, q, faqID.String(), locale); err != nil {
+		return fmt.Errorf("delete translation: %w", err)
+	}
+	event := domain.NewTranslationAuditEventInput(ctx, domain.AuditDelete, faq, locale, before, nil)
+	if _, err := appendAuditEvent(ctx, tx, event, r.now()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// findTranslation returns the translation of a FAQ into locale, or nil if
+// there is none.
+func findTranslation(ctx context.Context, tx *sql.Tx, faqID uuid.UUID, locale string) (*domain.Translation, error) {
+	const q = `SELECT ` + translationColumns + ` FROM faq_translations WHERE faq_id = ?1 AND locale = ?2`
+
+	out, err := scanTranslation(tx.QueryRowContext(ctx, q, faqID.String(), locale))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (r *FAQRepository) ListMissingTranslations(ctx context.Context, locale string) ([]domain.FAQ, error) {
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs f
+		WHERE f.tenant = ?2 AND f.deleted_at IS NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM faq_translations t
+			WHERE t.faq_id = f.id AND t.locale = ?1
+		)
+		ORDER BY position ASC, created_at ASC, id ASC
+	`
+
+	out, err := collectFAQs(r.db.QueryContext(ctx, q, locale, domain.TenantFromContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("list missing translations: %w", err)
+	}
+	return out, nil
+}
+
+func (r *FAQRepository) queryTranslations(ctx context.Context, q string, args ...any) ([]domain.Translation, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list translations: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.Translation, 0)
+	for rows.Next() {
+		t, err := scanTranslation(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate translations: %w", err)
+	}
+	return out, nil
+}
+
+func scanTranslation(s rowScanner) (domain.Translation, error) {
+	var (
+		out       domain.Translation
+		idRaw     string
+		createdAt string
+		updatedAt string
+	)
+	err := s.Scan(&idRaw, &out.Locale, &out.Title, &out.Content, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.Translation{}, fmt.Errorf("scan translation: %w", err)
+	}
+	if out.FAQID, err = uuid.Parse(idRaw); err != nil {
+		return domain.Translation{}, fmt.Errorf("parse faq id: %w", err)
+	}
+	if out.CreatedAt, err = parseTime(createdAt); err != nil {
+		return domain.Translation{}, err
+	}
+	if out.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return domain.Translation{}, err
+	}
+	return out, nil
+}
+
+func validateTranslationInput(locale, title, content string) error {
+	if _, ok := domain.NormalizeLocale(locale); !ok {
+		return domain.ValidationError{Message: "locale is invalid"}
+	}
+	return validateContent(title, content)
+}
+
+// placeholders returns n comma-separated parameter placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
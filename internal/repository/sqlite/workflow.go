@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// SaveDraft stores pending content and moves the FAQ back to draft.
+// Archived FAQs are left untouched and reported as not found.
+func (r *FAQRepository) SaveDraft(ctx context.Context, id uuid.UUID, draft domain.FAQDraft) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := validateContent(draft.Title, draft.Content); err != nil {
+		return domain.FAQ{}, err
+	}
+	const q = `
+		UPDATE faqs
+		SET draft_title = ?2, draft_content = ?3, status = 'draft', version = version + 1, updated_at = ?4
+		WHERE id = ?1 AND tenant = ?5 AND status <> 'archived' AND deleted_at IS NULL
+		RETURNING ` + faqColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), draft.Title, draft.Content, r.timestamp(),
+		domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("save draft: %w", err)
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionUpdate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, out.UpdatedAt, domain.AuditUpdate, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// ChangeStatus moves a FAQ from one status to another. The update only
+// applies while the FAQ is still in status from, otherwise a validation
+// error is returned. Publishing swaps the draft into the live content,
+// archiving withdraws it until the FAQ is published again.
+func (r *FAQRepository) ChangeStatus(ctx context.Context, id uuid.UUID, from, to domain.FAQStatus) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	const q = `
+		UPDATE faqs
+		SET status = ?2,
+			title = CASE WHEN ?2 = 'published' THEN COALESCE(draft_title, title) ELSE title END,
+			content = CASE WHEN ?2 = 'published' THEN COALESCE(draft_content, content) ELSE content END,
+			draft_title = CASE WHEN ?2 = 'published' THEN NULL ELSE draft_title END,
+			draft_content = CASE WHEN ?2 = 'published' THEN NULL ELSE draft_content END,
+			published_at = CASE ?2 WHEN 'published' THEN ?3 WHEN 'archived' THEN NULL ELSE published_at END,
+			version = version + 1,
+			updated_at = ?3
+		WHERE id = ?1
+		RETURNING ` + faqColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// the transaction holds the write lock, so the status cannot change
+	// between the two statements
+	before, err := lockFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if before.Status != from {
+		return domain.FAQ{}, domain.ValidationError{Message: "status has been changed concurrently"}
+	}
+
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), string(to), r.timestamp()))
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("change status: %w", err)
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionUpdate, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, out.UpdatedAt, domain.AuditUpdate, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+func draftArgs(draft *domain.FAQDraft) (any, any) {
+	if draft == nil {
+		return nil, nil
+	}
+	return draft.Title, draft.Content
+}
@@ -0,0 +1,126 @@
+// Package textmatch is a small word matcher used by storage backends that
+// have no full-text search engine.
+package textmatch
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Query is a parsed search query. Every included term must start a word
+// of the document, excluded terms (prefixed with "-") must not. Words are
+// compared case-insensitively and are not stemmed. Quotes and the "or"
+// operator are not supported and are ignored.
+type Query struct {
+	include []string
+	exclude []string
+}
+
+func Parse(q string) Query {
+	var out Query
+	for _, field := range strings.Fields(strings.ToLower(q)) {
+		negate := strings.HasPrefix(field, "-")
+		for _, w := range words(field) {
+			if w.text == "or" {
+				continue
+			}
+			if negate {
+				out.exclude = append(out.exclude, w.text)
+			} else {
+				out.include = append(out.include, w.text)
+			}
+		}
+	}
+	return out
+}
+
+// Empty reports whether the query has no terms to match.
+func (q Query) Empty() bool {
+	return len(q.include) == 0
+}
+
+// Rank reports whether a document with the given title and content
+// matches the query. The rank is the share of words matched, weighting
+// title matches twice.
+func (q Query) Rank(title, content string) (float64, bool) {
+	if q.Empty() {
+		return 0, false
+	}
+	titleWords, contentWords := words(title), words(content)
+	if matchesAny(titleWords, q.exclude) || matchesAny(contentWords, q.exclude) {
+		return 0, false
+	}
+
+	hits := 0
+	for _, term := range q.include {
+		n := 2*countMatches(titleWords, term) + countMatches(contentWords, term)
+		if n == 0 {
+			return 0, false
+		}
+		hits += n
+	}
+	return float64(hits) / float64(len(titleWords)+len(contentWords)), true
+}
+
+// Highlight escapes s and wraps the words matching the query into <mark>.
+func (q Query) Highlight(s string) string {
+	var b strings.Builder
+	last := 0
+	for _, w := range words(s) {
+		if !matchesAny([]word{w}, q.include) {
+			continue
+		}
+		b.WriteString(html.EscapeString(s[last:w.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(s[w.start:w.end]))
+		b.WriteString("</mark>")
+		last = w.end
+	}
+	b.WriteString(html.EscapeString(s[last:]))
+	return b.String()
+}
+
+type word struct {
+	text       string
+	start, end int
+}
+
+// words splits s into lowercase words with their byte offsets in s.
+func words(s string) []word {
+	out := make([]word, 0)
+	start := -1
+	for i, c := range s {
+		isWord := unicode.IsLetter(c) || unicode.IsDigit(c)
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			out = append(out, word{text: strings.ToLower(s[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		out = append(out, word{text: strings.ToLower(s[start:]), start: start, end: len(s)})
+	}
+	return out
+}
+
+func countMatches(ws []word, term string) int {
+	n := 0
+	for _, w := range ws {
+		if strings.HasPrefix(w.text, term) {
+			n++
+		}
+	}
+	return n
+}
+
+func matchesAny(ws []word, terms []string) bool {
+	for _, term := range terms {
+		if countMatches(ws, term) > 0 {
+			return true
+		}
+	}
+	return false
+}
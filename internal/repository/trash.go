@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (r *FAQRepository) ListDeleted(ctx context.Context) ([]domain.FAQ, error) {
+	const q = `
+		SELECT ` + faqColumns + `
+		FROM faqs
+		WHERE tenant = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, domain.TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list deleted faqs: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.FAQ, 0)
+	for rows.Next() {
+		faq, err := scanFAQ(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, faq)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate faqs: %w", err)
+	}
+	return out, nil
+}
+
+// Restore takes a FAQ out of the trash. FAQs that are not in the trash
+// are reported as not found. If its position has been taken meanwhile,
+// the FAQ is appended to the end of its category.
+func (r *FAQRepository) Restore(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	if err := validateFAQID(id); err != nil {
+		return domain.FAQ{}, err
+	}
+	const q = `
+		UPDATE faqs f
+		SET deleted_at = NULL, version = version + 1, updated_at = now(),
+			position = CASE
+				WHEN EXISTS (
+					SELECT 1 FROM faqs o
+					WHERE o.tenant = f.tenant AND o.deleted_at IS NULL
+					  AND o.category_id IS NOT DISTINCT FROM f.category_id AND o.position = f.position
+				)
+				THEN (
+					SELECT COALESCE(MAX(o.position), 0) + 1 FROM faqs o
+					WHERE o.tenant = f.tenant AND o.deleted_at IS NULL
+					  AND o.category_id IS NOT DISTINCT FROM f.category_id
+				)
+				ELSE f.position
+			END
+		WHERE id = $1 AND tenant = $2 AND deleted_at IS NOT NULL
+		RETURNING ` + faqColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.FAQ{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	before, err := lockStoredFAQ(ctx, tx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := scanFAQ(tx.QueryRowContext(ctx, q, id.String(), domain.TenantFromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.FAQ{}, domain.ErrNotFound
+		}
+		return domain.FAQ{}, fmt.Errorf("restore faq: %w", mapWriteError(err))
+	}
+	if err := insertRevision(ctx, tx, domain.RevisionRestore, out); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := insertAuditEvent(ctx, tx, domain.AuditRestore, &before, &out); err != nil {
+		return domain.FAQ{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.FAQ{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return out, nil
+}
+
+// Purge permanently removes a FAQ from the trash together with its
+// translations. Revisions are kept.
+func (r *FAQRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	if err := validateFAQID(id); err != nil {
+		return err
+	}
+	const q = `DELETE FROM faqs WHERE id = $1`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	before, err := lockStoredFAQ(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if before.DeletedAt == nil {
+		return domain.ErrNotFound
+	}
+	if _, err := tx.ExecContext(ctx, q, id.String()); err != nil {
+		return fmt.Errorf("purge faq: %w", err)
+	}
+	if err := insertAuditEvent(ctx, tx, domain.AuditPurge, &before, nil); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeleted permanently removes FAQs moved to the trash before the
+// given moment and returns how many were removed. domain.AnyTenant in the
+// context purges the trash of every tenant.
+func (r *FAQRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	const q = `
+		DELETE FROM faqs
+		WHERE ($2 = '*' OR tenant = $2) AND deleted_at IS NOT NULL AND deleted_at < $1
+		RETURNING ` + faqColumns + `, tenant`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	purged, err := collectPurged(tx.QueryContext(ctx, q, before, domain.TenantFromContext(ctx)))
+	if err != nil {
+		return 0, fmt.Errorf("purge faqs: %w", err)
+	}
+	// each event goes to the tenant of its FAQ, also when every tenant's
+	// trash is purged at once
+	for _, p := range purged {
+		if err := insertAuditEvent(domain.WithTenant(ctx, p.tenant), tx, domain.AuditPurge, &p.faq, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+	return len(purged), nil
+}
+
+type purgedFAQ struct {
+	faq    domain.FAQ
+	tenant string
+}
+
+// collectPurged reads the FAQs removed by a purge with their tenants.
+func collectPurged(rows *sql.Rows, err error) ([]purgedFAQ, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]purgedFAQ, 0)
+	for rows.Next() {
+		var p purgedFAQ
+		if p.faq, err = scanFAQ(rows, &p.tenant); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate faqs: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+type AuditService struct {
+	repo AuditRepository
+}
+
+func NewAuditService(repo AuditRepository) *AuditService {
+	return &AuditService{repo: repo}
+}
+
+// List returns a page of the audit log, newest first. The cursor of the
+// next page is set only when more events follow.
+func (s *AuditService) List(ctx context.Context, filter domain.AuditFilter, cursor string) (domain.AuditPage, error) {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return domain.AuditPage{}, err
+	}
+	filter.Actor = strings.TrimSpace(filter.Actor)
+	if filter.FAQID != nil && *filter.FAQID == uuid.Nil {
+		return domain.AuditPage{}, domain.ValidationError{Message: "faq_id is invalid"}
+	}
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return domain.AuditPage{}, domain.ValidationError{Message: "to must be after from"}
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultListLimit
+	case filter.Limit < 0 || filter.Limit > maxListLimit:
+		return domain.AuditPage{}, domain.ValidationError{Message: "limit must be between 1 and 100"}
+	}
+
+	if cursor != "" {
+		after, err := domain.DecodeAuditCursor(cursor)
+		if err != nil {
+			return domain.AuditPage{}, domain.ValidationError{Message: "cursor is invalid"}
+		}
+		filter.After = &after
+	}
+
+	// one extra row tells whether there is a next page
+	limit := filter.Limit
+	filter.Limit++
+	items, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return domain.AuditPage{}, err
+	}
+
+	page := domain.AuditPage{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = domain.NewAuditCursor(page.Items[limit-1]).Encode()
+	}
+	return page, nil
+}
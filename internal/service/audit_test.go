@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+func TestAuditLog(t *testing.T) {
+	store := memory.NewStore()
+	auditRepo := memory.NewAuditRepository(store)
+	faqs := service.NewFAQService(memory.NewFAQRepository(store))
+	audit := service.NewAuditService(auditRepo)
+
+	ctx := domain.WithActor(as(domain.RoleAdmin), "alice")
+	ctx = domain.WithRequestID(ctx, "req-1")
+	ctx = domain.WithClientIP(ctx, "10.0.0.1")
+
+	created, err := faqs.Create(ctx, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1, IsActive: true})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := faqs.Update(ctx, created.ID, domain.UpdateFAQInput{Title: "Delivery", Content: "C", Position: 1, IsActive: true}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := faqs.Patch(ctx, created.ID, domain.FAQPatch{Content: domain.PatchField[string]{Set: true, Value: "D"}}); err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+	if err := faqs.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	page, err := audit.List(as(domain.RoleAdmin), domain.AuditFilter{FAQID: &created.ID}, "")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	want := []domain.AuditAction{domain.AuditDelete, domain.AuditUpdate, domain.AuditUpdate, domain.AuditCreate}
+	if len(page.Items) != len(want) {
+		t.Fatalf("got %d events, want %d", len(page.Items), len(want))
+	}
+	for i, e := range page.Items {
+		if e.Action != want[i] || e.Actor != "alice" || e.RequestID != "req-1" || e.ClientIP != "10.0.0.1" {
+			t.Errorf("event %d = %s by %q (%s, %s), want %s by alice", i, e.Action, e.Actor, e.RequestID, e.ClientIP, want[i])
+		}
+	}
+	update := page.Items[2]
+	if update.Before == nil || update.Before.Title != "Shipping" || update.After == nil || update.After.Title != "Delivery" {
+		t.Errorf("update snapshots = %+v / %+v", update.Before, update.After)
+	}
+	if deleted := page.Items[0]; deleted.Before == nil || deleted.Before.Content != "D" || deleted.After != nil {
+		t.Errorf("delete snapshots = %+v / %+v", deleted.Before, deleted.After)
+	}
+
+	if _, err := audit.List(as(domain.RolePublisher), domain.AuditFilter{}, ""); !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("publisher lists audit log: err = %v, want forbidden", err)
+	}
+	if _, err := audit.List(context.Background(), domain.AuditFilter{}, ""); !errors.Is(err, domain.ErrUnauthorized) {
+		t.Errorf("anonymous lists audit log: err = %v, want unauthorized", err)
+	}
+}
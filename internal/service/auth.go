@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/pkg/jwks"
+)
+
+// apiKeyPrefix marks API keys so that they are easy to spot in logs and
+// secret scanners.
+const apiKeyPrefix = "faq_"
+
+// tokenMethods are the signing algorithms accepted in JWTs. Tokens signed
+// with anything else, including "none", are rejected before any key is
+// looked up.
+var tokenMethods = []string{"HS256", "HS384", "HS512", "RS256", "RS384", "RS512"}
+
+// defaultRoleClaim is the JWT claim holding the role of the caller.
+const defaultRoleClaim = "role"
+
+// defaultTenantClaim is the JWT claim holding the tenant of the caller.
+const defaultTenantClaim = "tenant"
+
+type AuthService struct {
+	apiKeys     APIKeyRepository
+	jwtKeys     *jwks.Set
+	issuer      string
+	audience    string
+	roleClaim   string
+	tenantClaim string
+	now         func() time.Time
+}
+
+type AuthOption func(*AuthService)
+
+// WithJWTKeys enables JWT authentication with the keys of the set.
+func WithJWTKeys(keys *jwks.Set) AuthOption {
+	return func(s *AuthService) {
+		s.jwtKeys = keys
+	}
+}
+
+// WithJWTIssuer requires the iss claim of tokens to equal issuer.
+func WithJWTIssuer(issuer string) AuthOption {
+	return func(s *AuthService) {
+		s.issuer = issuer
+	}
+}
+
+// WithJWTAudience requires the aud claim of tokens to contain audience.
+func WithJWTAudience(audience string) AuthOption {
+	return func(s *AuthService) {
+		s.audience = audience
+	}
+}
+
+// WithJWTRoleClaim sets the claim that carries the role. Tokens without it
+// get the viewer role.
+func WithJWTRoleClaim(claim string) AuthOption {
+	return func(s *AuthService) {
+		if claim != "" {
+			s.roleClaim = claim
+		}
+	}
+}
+
+// WithJWTTenantClaim sets the claim that carries the tenant. Tokens
+// without it belong to the default tenant.
+func WithJWTTenantClaim(claim string) AuthOption {
+	return func(s *AuthService) {
+		if claim != "" {
+			s.tenantClaim = claim
+		}
+	}
+}
+
+// WithAuthClock sets the source of the current time used to check token
+// expiry.
+func WithAuthClock(now func() time.Time) AuthOption {
+	return func(s *AuthService) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+func NewAuthService(apiKeys APIKeyRepository, opts ...AuthOption) *AuthService {
+	s := &AuthService{apiKeys: apiKeys, roleClaim: defaultRoleClaim, tenantClaim: defaultTenantClaim, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// AuthenticateAPIKey returns the principal of a valid, unrevoked API key.
+// The principal belongs to the tenant the key was created in.
+func (s *AuthService) AuthenticateAPIKey(ctx context.Context, key string) (domain.Principal, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return domain.Principal{}, domain.ErrUnauthorized
+	}
+	stored, err := s.apiKeys.GetByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Principal{}, domain.ErrUnauthorized
+		}
+		return domain.Principal{}, err
+	}
+	if stored.RevokedAt != nil {
+		return domain.Principal{}, domain.ErrUnauthorized
+	}
+	return domain.Principal{Subject: stored.Name, Method: domain.AuthMethodAPIKey, Role: stored.Role, Tenant: stored.Tenant}, nil
+}
+
+// AuthenticateToken verifies a signed JWT and returns the principal named
+// by its sub claim. Tokens must carry exp; iss and aud are checked when
+// configured. A token with an unknown role or an invalid tenant is
+// rejected.
+func (s *AuthService) AuthenticateToken(_ context.Context, token string) (domain.Principal, error) {
+	if s.jwtKeys == nil {
+		return domain.Principal{}, domain.ErrUnauthorized
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(tokenMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	}
+	if s.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(s.issuer))
+	}
+	if s.audience != "" {
+		opts = append(opts, jwt.WithAudience(s.audience))
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, s.tokenKeys, opts...); err != nil {
+		return domain.Principal{}, domain.ErrUnauthorized
+	}
+	subject, err := claims.GetSubject()
+	if err != nil || strings.TrimSpace(subject) == "" {
+		return domain.Principal{}, domain.ErrUnauthorized
+	}
+
+	role := domain.RoleViewer
+	if raw, ok := claims[s.roleClaim]; ok {
+		name, _ := raw.(string)
+		role = domain.Role(name)
+		if !role.Valid() {
+			return domain.Principal{}, domain.ErrUnauthorized
+		}
+	}
+	tenant := domain.DefaultTenant
+	if raw, ok := claims[s.tenantClaim]; ok {
+		tenant, _ = raw.(string)
+		if !domain.ValidTenant(tenant) {
+			return domain.Principal{}, domain.ErrUnauthorized
+		}
+	}
+	return domain.Principal{Subject: subject, Method: domain.AuthMethodJWT, Role: role, Tenant: tenant}, nil
+}
+
+func (s *AuthService) tokenKeys(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	keys := s.jwtKeys.Find(kid, token.Method.Alg())
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no key for kid %q and alg %s", kid, token.Method.Alg())
+	}
+	set := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(keys))}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, k)
+	}
+	return set, nil
+}
+
+// CreateAPIKey stores a new API key of the tenant in ctx and returns it
+// together with the key itself, which cannot be recovered later.
+func (s *AuthService) CreateAPIKey(ctx context.Context, name string, role domain.Role) (domain.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.APIKey{}, "", domain.ValidationError{Message: "name is required"}
+	}
+	if !role.Valid() {
+		return domain.APIKey{}, "", domain.ValidationError{Message: "role must be viewer, editor, publisher or admin"}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return domain.APIKey{}, "", fmt.Errorf("generate api key: %w", err)
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	created, err := s.apiKeys.Create(ctx, domain.CreateAPIKeyInput{Name: name, Role: role, Hash: hashAPIKey(key)})
+	if err != nil {
+		return domain.APIKey{}, "", err
+	}
+	return created, key, nil
+}
+
+func (s *AuthService) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	return s.apiKeys.List(ctx)
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	return s.apiKeys.Revoke(ctx, id)
+}
+
+// hashAPIKey returns the hex SHA-256 of a key. Keys carry 256 random bits,
+// so a fast unsalted hash is enough and keeps lookups by hash possible.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+	"github.com/nightmaker00/accordion-go/pkg/jwks"
+)
+
+func TestAuthenticateToken(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	b64 := base64.RawURLEncoding.EncodeToString
+	keys, err := jwks.Parse([]byte(`{"keys": [
+		{"kty": "oct", "kid": "hs", "alg": "HS256", "k": "` + b64(secret) + `"},
+		{"kty": "RSA", "kid": "rs", "n": "` + b64(rsaKey.N.Bytes()) + `", "e": "` + b64(big.NewInt(int64(rsaKey.E)).Bytes()) + `"}
+	]}`))
+	if err != nil {
+		t.Fatalf("parse keys: %v", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	auth := service.NewAuthService(memory.NewAPIKeyRepository(memory.NewStore()),
+		service.WithJWTKeys(keys),
+		service.WithJWTIssuer("https://issuer.example"),
+		service.WithJWTAudience("faq"),
+		service.WithAuthClock(func() time.Time { return now }),
+	)
+
+	// claims returns valid claims with the given ones added, or removed
+	// when their value is nil.
+	claims := func(extra jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"sub": "alice",
+			"iss": "https://issuer.example",
+			"aud": "faq",
+			"exp": now.Add(time.Hour).Unix(),
+		}
+		for k, v := range extra {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any, c jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, c)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("sign token: %v", err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  domain.Principal
+	}{
+		{"hs256", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"role": "editor", "tenant": "acme"})),
+			domain.Principal{Subject: "alice", Method: domain.AuthMethodJWT, Role: domain.RoleEditor, Tenant: "acme"}},
+		{"rs256 without role", sign(jwt.SigningMethodRS256, "rs", rsaKey, claims(nil)),
+			domain.Principal{Subject: "alice", Method: domain.AuthMethodJWT, Role: domain.RoleViewer, Tenant: domain.DefaultTenant}},
+		{"rs512 without kid", sign(jwt.SigningMethodRS512, "", rsaKey, claims(jwt.MapClaims{"role": "admin"})),
+			domain.Principal{Subject: "alice", Method: domain.AuthMethodJWT, Role: domain.RoleAdmin, Tenant: domain.DefaultTenant}},
+		{"expired", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"exp": now.Add(-time.Minute).Unix()})), domain.Principal{}},
+		{"missing exp", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"exp": nil})), domain.Principal{}},
+		{"not yet valid", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"nbf": now.Add(time.Minute).Unix()})), domain.Principal{}},
+		{"wrong issuer", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"iss": "https://other.example"})), domain.Principal{}},
+		{"missing issuer", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"iss": nil})), domain.Principal{}},
+		{"wrong audience", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"aud": []string{"billing"}})), domain.Principal{}},
+		{"hs256 with the rsa public key", sign(jwt.SigningMethodHS256, "rs", publicPEM, claims(jwt.MapClaims{"role": "admin"})), domain.Principal{}},
+		{"hs256 with the rsa modulus", sign(jwt.SigningMethodHS256, "rs", rsaKey.N.Bytes(), claims(jwt.MapClaims{"role": "admin"})), domain.Principal{}},
+		{"alg none", sign(jwt.SigningMethodNone, "hs", jwt.UnsafeAllowNoneSignatureType, claims(nil)), domain.Principal{}},
+		{"alg of another key", sign(jwt.SigningMethodHS512, "hs", secret, claims(nil)), domain.Principal{}},
+		{"unknown kid", sign(jwt.SigningMethodHS256, "other", secret, claims(nil)), domain.Principal{}},
+		{"wrong secret", sign(jwt.SigningMethodHS256, "hs", []byte("another secret of the same size!"), claims(nil)), domain.Principal{}},
+		{"invalid role", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"role": "owner"})), domain.Principal{}},
+		{"role not a string", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"role": []string{"admin"}})), domain.Principal{}},
+		{"invalid tenant", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"tenant": "Acme Inc"})), domain.Principal{}},
+		{"tenant not a string", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"tenant": 1})), domain.Principal{}},
+		{"missing subject", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"sub": nil})), domain.Principal{}},
+		{"blank subject", sign(jwt.SigningMethodHS256, "hs", secret, claims(jwt.MapClaims{"sub": " "})), domain.Principal{}},
+		{"garbage", "not.a.token", domain.Principal{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := auth.AuthenticateToken(context.Background(), tt.token)
+			if tt.want == (domain.Principal{}) {
+				if !errors.Is(err, domain.ErrUnauthorized) {
+					t.Fatalf("err = %v, want ErrUnauthorized", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("principal = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no keys configured", func(t *testing.T) {
+		auth := service.NewAuthService(memory.NewAPIKeyRepository(memory.NewStore()))
+		token := sign(jwt.SigningMethodHS256, "hs", secret, claims(nil))
+		if _, err := auth.AuthenticateToken(context.Background(), token); !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("err = %v, want ErrUnauthorized", err)
+		}
+	})
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	auth := service.NewAuthService(memory.NewAPIKeyRepository(memory.NewStore()))
+	acme := domain.WithTenant(context.Background(), "acme")
+
+	_, key, err := auth.CreateAPIKey(acme, "ci", domain.RoleEditor)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	revoked, revokedKey, err := auth.CreateAPIKey(acme, "old", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	if err := auth.RevokeAPIKey(acme, revoked.ID); err != nil {
+		t.Fatalf("revoke key: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		want domain.Principal
+	}{
+		{"valid", key, domain.Principal{Subject: "ci", Method: domain.AuthMethodAPIKey, Role: domain.RoleEditor, Tenant: "acme"}},
+		{"revoked", revokedKey, domain.Principal{}},
+		{"unknown", "faq_unknown", domain.Principal{}},
+		{"missing prefix", key[len("faq_"):], domain.Principal{}},
+		{"empty", "", domain.Principal{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := auth.AuthenticateAPIKey(context.Background(), tt.key)
+			if tt.want == (domain.Principal{}) {
+				if !errors.Is(err, domain.ErrUnauthorized) {
+					t.Fatalf("err = %v, want ErrUnauthorized", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("principal = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// authorize checks that the caller stored in ctx has at least the role
+// and belongs to the tenant of the request. Public reads do not call it,
+// so a request without a principal only reaches them.
+func authorize(ctx context.Context, role domain.Role) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrUnauthorized
+	}
+	if principal.Tenant != "" && principal.Tenant != domain.TenantFromContext(ctx) {
+		return domain.ErrForbidden
+	}
+	if !principal.Role.Includes(role) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+func hasRole(ctx context.Context, role domain.Role) bool {
+	return authorize(ctx, role) == nil
+}
+
+// authorizeEdit checks a direct change of a FAQ. Editors may only change
+// FAQs that have never been live, and only publishers toggle is_active;
+// editors change live FAQs through drafts.
+func authorizeEdit(ctx context.Context, current domain.FAQ, togglesActive bool) error {
+	if hasRole(ctx, domain.RolePublisher) {
+		return nil
+	}
+	if current.PublishedAt != nil || togglesActive {
+		return domain.ErrForbidden
+	}
+	return nil
+}
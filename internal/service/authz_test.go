@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+func as(role domain.Role) context.Context {
+	return domain.WithPrincipal(context.Background(), domain.Principal{Subject: string(role), Role: role})
+}
+
+func TestRoles(t *testing.T) {
+	store := memory.NewStore()
+	faqs := service.NewFAQService(memory.NewFAQRepository(store))
+	admin := as(domain.RoleAdmin)
+
+	live, err := faqs.Create(admin, domain.CreateFAQInput{Title: "Live", Content: "C", Position: 1, IsActive: true})
+	if err != nil {
+		t.Fatalf("create live: %v", err)
+	}
+	draft, err := faqs.Create(as(domain.RoleEditor), domain.CreateFAQInput{
+		Title: "Draft", Content: "C", Position: 2, IsActive: true, Status: domain.StatusDraft,
+	})
+	if err != nil {
+		t.Fatalf("editor creates draft: %v", err)
+	}
+	// a category that has never had a live FAQ
+	category, err := memory.NewCategoryRepository(store).Create(admin, domain.CreateCategoryInput{Name: "Soon", Slug: "soon", Position: 1})
+	if err != nil {
+		t.Fatalf("create category: %v", err)
+	}
+	var drafts []uuid.UUID
+	for i := range 2 {
+		f, err := faqs.Create(as(domain.RoleEditor), domain.CreateFAQInput{
+			CategoryID: &category.ID, Title: "Soon", Content: "C", Position: i + 1, IsActive: true, Status: domain.StatusDraft,
+		})
+		if err != nil {
+			t.Fatalf("editor creates draft in category: %v", err)
+		}
+		drafts = append(drafts, f.ID)
+	}
+
+	edit := func(f domain.FAQ, isActive bool) domain.UpdateFAQInput {
+		return domain.UpdateFAQInput{Title: f.Title + "!", Content: f.Content, Position: f.Position, IsActive: isActive}
+	}
+	tests := []struct {
+		name string
+		role domain.Role
+		call func(ctx context.Context) error
+		want error
+	}{
+		{"anonymous admin list", "", func(ctx context.Context) error {
+			_, err := faqs.List(ctx, domain.FAQListFilter{}, "")
+			return err
+		}, domain.ErrUnauthorized},
+		{"viewer admin list", domain.RoleViewer, func(ctx context.Context) error {
+			_, err := faqs.List(ctx, domain.FAQListFilter{}, "")
+			return err
+		}, nil},
+		{"viewer creates", domain.RoleViewer, func(ctx context.Context) error {
+			_, err := faqs.Create(ctx, domain.CreateFAQInput{Title: "T", Content: "C", Position: 3, Status: domain.StatusDraft})
+			return err
+		}, domain.ErrForbidden},
+		{"editor publishes on create", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Create(ctx, domain.CreateFAQInput{Title: "T", Content: "C", Position: 3})
+			return err
+		}, domain.ErrForbidden},
+		{"editor edits draft", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Update(ctx, draft.ID, edit(draft, true))
+			return err
+		}, nil},
+		{"editor toggles is_active", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Update(ctx, draft.ID, edit(draft, false))
+			return err
+		}, domain.ErrForbidden},
+		{"editor edits live faq", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Update(ctx, live.ID, edit(live, true))
+			return err
+		}, domain.ErrForbidden},
+		{"editor saves draft of live faq", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.SaveDraft(ctx, live.ID, domain.FAQDraft{Title: "Next", Content: "C"})
+			return err
+		}, nil},
+		{"editor sends to review", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.ChangeStatus(ctx, live.ID, domain.StatusInReview)
+			return err
+		}, nil},
+		{"editor publishes", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Publish(ctx, live.ID)
+			return err
+		}, domain.ErrForbidden},
+		{"publisher toggles is_active", domain.RolePublisher, func(ctx context.Context) error {
+			_, err := faqs.Patch(ctx, live.ID, domain.FAQPatch{IsActive: domain.PatchField[bool]{Set: true, Value: false}})
+			return err
+		}, nil},
+		{"publisher publishes", domain.RolePublisher, func(ctx context.Context) error {
+			_, err := faqs.Publish(ctx, live.ID)
+			return err
+		}, nil},
+		{"editor reorders live faqs", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Reorder(ctx, domain.ReorderInput{IDs: []uuid.UUID{draft.ID, live.ID}})
+			return err
+		}, domain.ErrForbidden},
+		{"editor moves next to live faq", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Move(ctx, domain.MoveInput{ID: draft.ID, Target: live.ID})
+			return err
+		}, domain.ErrForbidden},
+		{"editor reorders drafts", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Reorder(ctx, domain.ReorderInput{CategoryID: &category.ID, IDs: []uuid.UUID{drafts[1], drafts[0]}})
+			return err
+		}, nil},
+		{"editor moves draft", domain.RoleEditor, func(ctx context.Context) error {
+			_, err := faqs.Move(ctx, domain.MoveInput{ID: drafts[1], Target: drafts[0], After: true})
+			return err
+		}, nil},
+		{"publisher reorders live faqs", domain.RolePublisher, func(ctx context.Context) error {
+			_, err := faqs.Reorder(ctx, domain.ReorderInput{IDs: []uuid.UUID{draft.ID, live.ID}})
+			return err
+		}, nil},
+		{"publisher deletes", domain.RolePublisher, func(ctx context.Context) error {
+			return faqs.Delete(ctx, draft.ID)
+		}, domain.ErrForbidden},
+		{"admin deletes", domain.RoleAdmin, func(ctx context.Context) error {
+			return faqs.Delete(ctx, draft.ID)
+		}, nil},
+		{"viewer exports", domain.RoleViewer, func(ctx context.Context) error {
+			_, err := faqs.Export(ctx)
+			return err
+		}, nil},
+		{"publisher imports", domain.RolePublisher, func(ctx context.Context) error {
+			_, err := faqs.Import(ctx, domain.ImportInput{DryRun: true})
+			return err
+		}, domain.ErrForbidden},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.role != "" {
+			ctx = as(tt.role)
+		}
+		if err := tt.call(ctx); !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestTenantPrincipal(t *testing.T) {
+	faqs := service.NewFAQService(memory.NewFAQRepository(memory.NewStore()))
+	acme := domain.WithPrincipal(context.Background(), domain.Principal{Subject: "ci", Role: domain.RoleAdmin, Tenant: "acme"})
+
+	in := domain.CreateFAQInput{Title: "T", Content: "C", Position: 1, IsActive: true}
+	if _, err := faqs.Create(domain.WithTenant(acme, "acme"), in); err != nil {
+		t.Fatalf("create in own tenant: %v", err)
+	}
+	if _, err := faqs.Create(domain.WithTenant(acme, "globex"), in); !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("create in another tenant: err = %v, want ErrForbidden", err)
+	}
+}
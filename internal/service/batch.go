@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// maxBatchOperations bounds the size of a single batch.
+const maxBatchOperations = 100
+
+var errInvalidBatchAction = domain.ValidationError{Message: "op must be create, update or delete"}
+
+// Batch applies creates, updates and deletes in order. An atomic batch is
+// stored in one transaction: when any operation fails, the failing ones
+// carry their error and all others domain.ErrBatchRolledBack. Otherwise
+// every operation runs on its own, exactly as the single-FAQ methods.
+// Each operation is checked against the caller's role like those methods.
+func (s *FAQService) Batch(ctx context.Context, ops []domain.BatchOperation, atomic bool) ([]domain.BatchResult, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return nil, err
+	}
+	if len(ops) == 0 {
+		return nil, domain.ValidationError{Message: "operations are required"}
+	}
+	if len(ops) > maxBatchOperations {
+		return nil, domain.ValidationError{Message: fmt.Sprintf("batch is limited to %d operations", maxBatchOperations)}
+	}
+
+	if atomic {
+		return s.batchAtomic(ctx, slices.Clone(ops))
+	}
+	results := make([]domain.BatchResult, len(ops))
+	for i, op := range ops {
+		switch op.Action {
+		case domain.BatchCreate:
+			f, err := s.Create(ctx, op.Create)
+			results[i] = batchResult(f, err)
+		case domain.BatchUpdate:
+			f, err := s.Update(ctx, op.ID, op.Update)
+			results[i] = batchResult(f, err)
+		case domain.BatchDelete:
+			results[i].Err = s.Delete(ctx, op.ID)
+		default:
+			results[i].Err = errInvalidBatchAction
+		}
+	}
+	return results, nil
+}
+
+// batchAtomic checks every operation up front, so that all faulty ones
+// are reported together, and then hands them to the repository at once.
+func (s *FAQService) batchAtomic(ctx context.Context, ops []domain.BatchOperation) ([]domain.BatchResult, error) {
+	results := make([]domain.BatchResult, len(ops))
+	failed := false
+	for i := range ops {
+		op := &ops[i]
+		var err error
+		switch op.Action {
+		case domain.BatchCreate:
+			err = s.prepareCreate(ctx, &op.Create)
+		case domain.BatchUpdate:
+			err = s.prepareUpdate(ctx, op.ID, op.Update)
+		case domain.BatchDelete:
+			err = s.prepareDelete(ctx, op.ID)
+		default:
+			err = errInvalidBatchAction
+		}
+		if err != nil {
+			results[i].Err = err
+			failed = true
+		}
+	}
+	if failed {
+		return rollBackBatch(results), nil
+	}
+
+	out, err := s.repo.Batch(ctx, ops)
+	if err != nil {
+		var batchErr domain.BatchError
+		if !errors.As(err, &batchErr) {
+			return nil, err
+		}
+		results[batchErr.Index].Err = batchErr.Err
+		return rollBackBatch(results), nil
+	}
+
+	for i, op := range ops {
+		f := out[i]
+		if op.Action != domain.BatchDelete {
+			f.Locale = s.defaultLocale
+			results[i].FAQ = &f
+		}
+	}
+	return results, nil
+}
+
+func batchResult(f domain.FAQ, err error) domain.BatchResult {
+	if err != nil {
+		return domain.BatchResult{Err: err}
+	}
+	return domain.BatchResult{FAQ: &f}
+}
+
+// rollBackBatch marks every operation without an error of its own as
+// undone.
+func rollBackBatch(results []domain.BatchResult) []domain.BatchResult {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i].Err = domain.ErrBatchRolledBack
+		}
+	}
+	return results
+}
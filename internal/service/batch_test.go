@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+func TestBatch(t *testing.T) {
+	store := memory.NewStore()
+	auditRepo := memory.NewAuditRepository(store)
+	faqs := service.NewFAQService(memory.NewFAQRepository(store))
+	ctx := as(domain.RoleEditor)
+
+	created, err := faqs.Create(ctx, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1, IsActive: true, Status: domain.StatusDraft})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	ops := []domain.BatchOperation{
+		{Action: domain.BatchUpdate, ID: created.ID, Update: domain.UpdateFAQInput{Title: "Delivery", Content: "C", Position: 1, IsActive: true}},
+		{Action: domain.BatchCreate, Create: domain.CreateFAQInput{Title: "Returns", Content: "C", Position: 2, IsActive: true, Status: domain.StatusDraft}},
+		{Action: domain.BatchDelete, ID: created.ID},
+	}
+
+	// editors may not delete, so the atomic batch stores nothing
+	results, err := faqs.Batch(ctx, ops, true)
+	if err != nil {
+		t.Fatalf("atomic batch: %v", err)
+	}
+	if !errors.Is(results[0].Err, domain.ErrBatchRolledBack) || !errors.Is(results[1].Err, domain.ErrBatchRolledBack) ||
+		!errors.Is(results[2].Err, domain.ErrForbidden) {
+		t.Errorf("atomic results = %+v", results)
+	}
+	if got, err := faqs.GetByID(ctx, created.ID); err != nil || got.Title != "Shipping" {
+		t.Errorf("rolled back batch changed the faq: %+v, %v", got, err)
+	}
+
+	results, err = faqs.Batch(ctx, ops, false)
+	if err != nil {
+		t.Fatalf("best-effort batch: %v", err)
+	}
+	if results[0].Err != nil || results[0].FAQ.Title != "Delivery" || results[1].Err != nil || !errors.Is(results[2].Err, domain.ErrForbidden) {
+		t.Errorf("best-effort results = %+v", results)
+	}
+
+	results, err = faqs.Batch(as(domain.RoleAdmin), ops[2:], true)
+	if err != nil || results[0].Err != nil || results[0].FAQ != nil {
+		t.Fatalf("admin batch = %+v, %v", results, err)
+	}
+	events, err := auditRepo.List(as(domain.RoleAdmin), domain.AuditFilter{FAQID: &created.ID})
+	if err != nil {
+		t.Fatalf("list audit: %v", err)
+	}
+	if len(events) != 3 || events[0].Action != domain.AuditDelete || events[0].Before == nil || events[0].Before.Title != "Delivery" {
+		t.Errorf("audit events = %+v", events)
+	}
+
+	if _, err := faqs.Batch(ctx, nil, true); !errors.As(err, new(domain.ValidationError)) {
+		t.Errorf("empty batch: err = %v, want a validation error", err)
+	}
+}
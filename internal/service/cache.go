@@ -0,0 +1,288 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// CachedFAQRepository memoizes the public read path of a FAQRepository:
+// active lists per tenant and category and their translations per locale
+// chain. Keys start with the tenant of the context.
+// Every write through the repository drops the whole cache.
+//
+// Entries live for the TTL, or until the first cached FAQ expires. FAQs
+// whose publish_at passes while a list is cached, as well as changes made
+// bypassing this repository (e.g. deleting a category), show up once the
+// entry expires, so the TTL bounds how stale a list can be.
+//
+// Methods that are not overridden are delegated unchanged; any new
+// write method of FAQRepository must be overridden here to invalidate.
+type CachedFAQRepository struct {
+	FAQRepository
+
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List
+	generation uint64
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type cacheEntry struct {
+	key     string
+	value   any
+	expires time.Time
+}
+
+// CacheStats reports cache effectiveness.
+type CacheStats struct {
+	Hits    uint64 `json:"hits"`
+	Misses  uint64 `json:"misses"`
+	Entries int    `json:"entries"`
+}
+
+type CacheOption func(*CachedFAQRepository)
+
+// WithCacheClock sets the source of the current time used to expire
+// entries. Active lists are checked against the time they are listed at.
+func WithCacheClock(now func() time.Time) CacheOption {
+	return func(c *CachedFAQRepository) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// NewCachedFAQRepository wraps repo with a cache of at most maxEntries
+// entries kept for ttl.
+func NewCachedFAQRepository(repo FAQRepository, ttl time.Duration, maxEntries int, opts ...CacheOption) *CachedFAQRepository {
+	c := &CachedFAQRepository{
+		FAQRepository: repo,
+		ttl:           ttl,
+		maxEntries:    maxEntries,
+		now:           time.Now,
+		entries:       make(map[string]*list.Element),
+		lru:           list.New(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *CachedFAQRepository) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.lru.Len()
+	c.mu.Unlock()
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Entries: entries}
+}
+
+func (c *CachedFAQRepository) ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error) {
+	key := domain.TenantFromContext(ctx) + ":list:"
+	if filter.CategoryID != nil {
+		key += filter.CategoryID.String()
+	}
+	now := filter.At
+	if now.IsZero() {
+		now = c.now()
+	}
+
+	// the list and its modification time are kept together, so that the
+	// time never gets ahead of a list cached earlier
+	if v, ok := c.get(key, now); ok {
+		out := v.(domain.ActiveFAQs)
+		out.Items = append([]domain.FAQ(nil), out.Items...)
+		return out, nil
+	}
+
+	generation := c.currentGeneration()
+	out, err := c.FAQRepository.ListActive(ctx, filter)
+	if err != nil {
+		return domain.ActiveFAQs{}, err
+	}
+
+	expires := now.Add(c.ttl)
+	for _, it := range out.Items {
+		if it.ExpireAt != nil && it.ExpireAt.Before(expires) {
+			expires = *it.ExpireAt
+		}
+	}
+	cached := out
+	cached.Items = append([]domain.FAQ(nil), out.Items...)
+	c.put(generation, key, cached, expires)
+	return out, nil
+}
+
+func (c *CachedFAQRepository) FindTranslations(ctx context.Context, faqIDs []uuid.UUID, locales []string) ([]domain.Translation, error) {
+	ids := make([]string, 0, len(faqIDs))
+	for _, id := range faqIDs {
+		ids = append(ids, id.String())
+	}
+	sort.Strings(ids)
+	key := domain.TenantFromContext(ctx) + ":translations:" + strings.Join(locales, ",") + ":" + strings.Join(ids, ",")
+	now := c.now()
+
+	if v, ok := c.get(key, now); ok {
+		return append([]domain.Translation(nil), v.([]domain.Translation)...), nil
+	}
+
+	generation := c.currentGeneration()
+	items, err := c.FAQRepository.FindTranslations(ctx, faqIDs, locales)
+	if err != nil {
+		return nil, err
+	}
+	c.put(generation, key, append([]domain.Translation(nil), items...), now.Add(c.ttl))
+	return items, nil
+}
+
+func (c *CachedFAQRepository) Create(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Create(ctx, in)
+}
+
+func (c *CachedFAQRepository) Update(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Update(ctx, id, in)
+}
+
+func (c *CachedFAQRepository) Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Patch(ctx, id, patch)
+}
+
+func (c *CachedFAQRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	defer c.invalidate()
+	return c.FAQRepository.Delete(ctx, id)
+}
+
+func (c *CachedFAQRepository) UpsertTranslation(ctx context.Context, in domain.UpsertTranslationInput) (domain.Translation, error) {
+	defer c.invalidate()
+	return c.FAQRepository.UpsertTranslation(ctx, in)
+}
+
+func (c *CachedFAQRepository) DeleteTranslation(ctx context.Context, faqID uuid.UUID, locale string) error {
+	defer c.invalidate()
+	return c.FAQRepository.DeleteTranslation(ctx, faqID, locale)
+}
+
+func (c *CachedFAQRepository) RestoreRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.RestoreRevision(ctx, faqID, number)
+}
+
+func (c *CachedFAQRepository) SaveDraft(ctx context.Context, id uuid.UUID, draft domain.FAQDraft) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.SaveDraft(ctx, id, draft)
+}
+
+func (c *CachedFAQRepository) ChangeStatus(ctx context.Context, id uuid.UUID, from, to domain.FAQStatus) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.ChangeStatus(ctx, id, from, to)
+}
+
+func (c *CachedFAQRepository) Restore(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Restore(ctx, id)
+}
+
+func (c *CachedFAQRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	defer c.invalidate()
+	return c.FAQRepository.Purge(ctx, id)
+}
+
+func (c *CachedFAQRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	defer c.invalidate()
+	return c.FAQRepository.PurgeDeleted(ctx, before)
+}
+
+func (c *CachedFAQRepository) Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Reorder(ctx, in)
+}
+
+func (c *CachedFAQRepository) Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Move(ctx, in)
+}
+
+func (c *CachedFAQRepository) Import(ctx context.Context, in domain.ImportInput) (domain.ImportResult, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Import(ctx, in)
+}
+
+func (c *CachedFAQRepository) Batch(ctx context.Context, ops []domain.BatchOperation) ([]domain.FAQ, error) {
+	defer c.invalidate()
+	return c.FAQRepository.Batch(ctx, ops)
+}
+
+func (c *CachedFAQRepository) get(key string, now time.Time) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !now.Before(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	c.hits.Add(1)
+	return entry.value, true
+}
+
+// put stores a value loaded while the cache was at the given generation.
+// Values loaded before an invalidation are dropped, since they may
+// already be stale.
+func (c *CachedFAQRepository) put(generation uint64, key string, value any, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation || c.maxEntries <= 0 {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = &cacheEntry{key: key, value: value, expires: expires}
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: value, expires: expires})
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *CachedFAQRepository) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+func (c *CachedFAQRepository) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
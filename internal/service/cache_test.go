@@ -0,0 +1,280 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+// countingRepo counts the reads that reach the repository behind the
+// cache.
+type countingRepo struct {
+	service.FAQRepository
+
+	lists        int
+	translations int
+	// afterList runs once, after the next list is loaded and before it is
+	// returned to the cache
+	afterList func()
+}
+
+func (r *countingRepo) ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error) {
+	r.lists++
+	out, err := r.FAQRepository.ListActive(ctx, filter)
+	if f := r.afterList; f != nil {
+		r.afterList = nil
+		f()
+	}
+	return out, err
+}
+
+func (r *countingRepo) FindTranslations(ctx context.Context, faqIDs []uuid.UUID, locales []string) ([]domain.Translation, error) {
+	r.translations++
+	return r.FAQRepository.FindTranslations(ctx, faqIDs, locales)
+}
+
+var cacheStart = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newCountingCache(maxEntries int) (*service.CachedFAQRepository, *countingRepo, *time.Time) {
+	now := cacheStart
+	repo := &countingRepo{FAQRepository: memory.NewFAQRepository(memory.NewStore(memory.WithClock(func() time.Time { return now })))}
+	cache := service.NewCachedFAQRepository(repo, time.Minute, maxEntries,
+		service.WithCacheClock(func() time.Time { return now }))
+	return cache, repo, &now
+}
+
+func listAt(t *testing.T, ctx context.Context, cache *service.CachedFAQRepository, categoryID *uuid.UUID, at time.Time) []domain.FAQ {
+	t.Helper()
+	out, err := cache.ListActive(ctx, domain.ListActiveFilter{CategoryID: categoryID, At: at})
+	if err != nil {
+		t.Fatalf("list active: %v", err)
+	}
+	return out.Items
+}
+
+func TestCacheHitsAndMisses(t *testing.T) {
+	cache, repo, now := newCountingCache(10)
+	ctx := context.Background()
+	if _, err := repo.FAQRepository.Create(ctx, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1, IsActive: true, Status: domain.StatusPublished}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	first := listAt(t, ctx, cache, nil, *now)
+	first[0].Title = "changed by the caller"
+	second := listAt(t, ctx, cache, nil, *now)
+	if repo.lists != 1 {
+		t.Errorf("repository lists = %d, want 1", repo.lists)
+	}
+	if second[0].Title != "Shipping" {
+		t.Errorf("cached title = %q, want a copy kept from the caller", second[0].Title)
+	}
+	other := uuid.New()
+	listAt(t, ctx, cache, &other, *now)
+
+	ids := []uuid.UUID{second[0].ID}
+	for range 2 {
+		if _, err := cache.FindTranslations(ctx, ids, []string{"de", "fr"}); err != nil {
+			t.Fatalf("find translations: %v", err)
+		}
+	}
+	if _, err := cache.FindTranslations(ctx, ids, []string{"de"}); err != nil {
+		t.Fatalf("find translations: %v", err)
+	}
+	if repo.translations != 2 {
+		t.Errorf("repository translation lookups = %d, want 2", repo.translations)
+	}
+
+	if got, want := cache.Stats(), (service.CacheStats{Hits: 2, Misses: 4, Entries: 4}); got != want {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	cache, repo, now := newCountingCache(10)
+	ctx := context.Background()
+	ids := []uuid.UUID{uuid.New()}
+
+	listAt(t, ctx, cache, nil, *now)
+	if _, err := cache.FindTranslations(ctx, ids, []string{"de"}); err != nil {
+		t.Fatalf("find translations: %v", err)
+	}
+
+	*now = cacheStart.Add(time.Minute - time.Nanosecond)
+	listAt(t, ctx, cache, nil, *now)
+	if _, err := cache.FindTranslations(ctx, ids, []string{"de"}); err != nil {
+		t.Fatalf("find translations: %v", err)
+	}
+	if repo.lists != 1 || repo.translations != 1 {
+		t.Errorf("within the TTL: repository reads = %d / %d, want 1 / 1", repo.lists, repo.translations)
+	}
+
+	*now = cacheStart.Add(time.Minute)
+	listAt(t, ctx, cache, nil, *now)
+	if _, err := cache.FindTranslations(ctx, ids, []string{"de"}); err != nil {
+		t.Fatalf("find translations: %v", err)
+	}
+	if repo.lists != 2 || repo.translations != 2 {
+		t.Errorf("after the TTL: repository reads = %d / %d, want 2 / 2", repo.lists, repo.translations)
+	}
+}
+
+func TestCacheExpiresWithFAQ(t *testing.T) {
+	cache, repo, now := newCountingCache(10)
+	ctx := context.Background()
+	expireAt := cacheStart.Add(10 * time.Second)
+	if _, err := repo.FAQRepository.Create(ctx, domain.CreateFAQInput{Title: "Sale", Content: "C", Position: 1, IsActive: true, Status: domain.StatusPublished, ExpireAt: &expireAt}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if items := listAt(t, ctx, cache, nil, *now); len(items) != 1 {
+		t.Fatalf("before expiry: got %d faqs, want 1", len(items))
+	}
+	if items := listAt(t, ctx, cache, nil, expireAt); len(items) != 0 || repo.lists != 2 {
+		t.Errorf("at expiry: got %d faqs after %d reads, want none after 2", len(items), repo.lists)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	cache, repo, now := newCountingCache(2)
+	ctx := context.Background()
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	listAt(t, ctx, cache, &a, *now)
+	listAt(t, ctx, cache, &b, *now)
+	// a is used again, so b is the least recently used one
+	listAt(t, ctx, cache, &a, *now)
+	listAt(t, ctx, cache, &c, *now)
+	if entries := cache.Stats().Entries; entries != 2 {
+		t.Errorf("entries = %d, want 2", entries)
+	}
+	if repo.lists != 3 {
+		t.Fatalf("repository lists = %d, want 3", repo.lists)
+	}
+
+	listAt(t, ctx, cache, &a, *now)
+	if repo.lists != 3 {
+		t.Errorf("a was evicted")
+	}
+	listAt(t, ctx, cache, &b, *now)
+	if repo.lists != 4 {
+		t.Errorf("b was kept")
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	id := uuid.New()
+	writes := map[string]func(ctx context.Context, c *service.CachedFAQRepository){
+		"Create": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Create(ctx, domain.CreateFAQInput{Title: "T", Content: "C", Position: 9})
+		},
+		"Update": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Update(ctx, id, domain.UpdateFAQInput{})
+		},
+		"Patch": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Patch(ctx, id, domain.FAQPatch{})
+		},
+		"Delete": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_ = c.Delete(ctx, id)
+		},
+		"UpsertTranslation": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.UpsertTranslation(ctx, domain.UpsertTranslationInput{FAQID: id})
+		},
+		"DeleteTranslation": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_ = c.DeleteTranslation(ctx, id, "de")
+		},
+		"RestoreRevision": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.RestoreRevision(ctx, id, 1)
+		},
+		"SaveDraft": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.SaveDraft(ctx, id, domain.FAQDraft{})
+		},
+		"ChangeStatus": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.ChangeStatus(ctx, id, domain.StatusDraft, domain.StatusInReview)
+		},
+		"Restore": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Restore(ctx, id)
+		},
+		"Purge": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_ = c.Purge(ctx, id)
+		},
+		"PurgeDeleted": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.PurgeDeleted(ctx, cacheStart)
+		},
+		"Reorder": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Reorder(ctx, domain.ReorderInput{IDs: []uuid.UUID{id}})
+		},
+		"Move": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Move(ctx, domain.MoveInput{ID: id, Target: id})
+		},
+		"Import": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Import(ctx, domain.ImportInput{Strategy: domain.ImportUpsert})
+		},
+		"Batch": func(ctx context.Context, c *service.CachedFAQRepository) {
+			_, _ = c.Batch(ctx, nil)
+		},
+	}
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			cache, repo, now := newCountingCache(10)
+			ctx := context.Background()
+
+			listAt(t, ctx, cache, nil, *now)
+			// failed writes drop the cache as well, since they may have
+			// changed something before failing
+			write(ctx, cache)
+			if entries := cache.Stats().Entries; entries != 0 {
+				t.Errorf("entries after %s = %d, want 0", name, entries)
+			}
+			listAt(t, ctx, cache, nil, *now)
+			if repo.lists != 2 {
+				t.Errorf("repository lists = %d, want 2", repo.lists)
+			}
+		})
+	}
+}
+
+func TestCacheTenants(t *testing.T) {
+	cache, repo, now := newCountingCache(10)
+	acme := domain.WithTenant(context.Background(), "acme")
+	globex := domain.WithTenant(context.Background(), "globex")
+	if _, err := repo.FAQRepository.Create(acme, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1, IsActive: true, Status: domain.StatusPublished}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if items := listAt(t, acme, cache, nil, *now); len(items) != 1 {
+		t.Errorf("acme: got %d faqs, want 1", len(items))
+	}
+	if items := listAt(t, globex, cache, nil, *now); len(items) != 0 {
+		t.Errorf("globex: got %d faqs, want none from the acme entry", len(items))
+	}
+	if repo.lists != 2 {
+		t.Errorf("repository lists = %d, want one per tenant", repo.lists)
+	}
+}
+
+func TestCacheDropsLoadRacingWrite(t *testing.T) {
+	cache, repo, now := newCountingCache(10)
+	ctx := context.Background()
+
+	// a write lands while the list is being loaded, so the loaded list
+	// misses it and must not be kept
+	repo.afterList = func() {
+		if _, err := cache.Create(ctx, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1, IsActive: true, Status: domain.StatusPublished}); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	if items := listAt(t, ctx, cache, nil, *now); len(items) != 0 {
+		t.Fatalf("racing load: got %d faqs, want the list from before the write", len(items))
+	}
+	if entries := cache.Stats().Entries; entries != 0 {
+		t.Errorf("entries = %d, want the stale list dropped", entries)
+	}
+	if items := listAt(t, ctx, cache, nil, *now); len(items) != 1 || repo.lists != 2 {
+		t.Errorf("after the write: got %d faqs after %d reads, want 1 after 2", len(items), repo.lists)
+	}
+}
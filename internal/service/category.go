@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+type CategoryService struct {
+	repo CategoryRepository
+}
+
+func NewCategoryService(repo CategoryRepository) *CategoryService {
+	return &CategoryService{repo: repo}
+}
+
+func (s *CategoryService) List(ctx context.Context) ([]domain.Category, error) {
+	items, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *CategoryService) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
+	if id == uuid.Nil {
+		return domain.Category{}, domain.ValidationError{Message: "id is required"}
+	}
+	out, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return out, nil
+}
+
+func (s *CategoryService) Create(ctx context.Context, in domain.CreateCategoryInput) (domain.Category, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return domain.Category{}, err
+	}
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	if in.ParentID != nil {
+		if err := s.checkParent(ctx, uuid.Nil, *in.ParentID); err != nil {
+			return domain.Category{}, err
+		}
+	}
+	out, err := s.repo.Create(ctx, in)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return out, nil
+}
+
+func (s *CategoryService) Update(ctx context.Context, id uuid.UUID, in domain.UpdateCategoryInput) (domain.Category, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return domain.Category{}, err
+	}
+	if id == uuid.Nil {
+		return domain.Category{}, domain.ValidationError{Message: "id is required"}
+	}
+	if err := validateCategoryInput(in.Name, in.Slug, in.Position); err != nil {
+		return domain.Category{}, err
+	}
+	if in.ParentID != nil {
+		if err := s.checkParent(ctx, id, *in.ParentID); err != nil {
+			return domain.Category{}, err
+		}
+	}
+	out, err := s.repo.Update(ctx, id, in)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return out, nil
+}
+
+func (s *CategoryService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return err
+	}
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Group arranges active FAQs into the category tree. Sections without
+// FAQs anywhere below them are left out, FAQs without a known category
+// end up in a trailing group with a nil Category.
+func (s *CategoryService) Group(ctx context.Context, faqs []domain.FAQ) ([]domain.FAQGroup, error) {
+	categories, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[uuid.UUID]bool, len(categories))
+	for _, c := range categories {
+		known[c.ID] = true
+	}
+
+	children := make(map[uuid.UUID][]domain.Category)
+	roots := make([]domain.Category, 0)
+	for _, c := range categories {
+		if c.ParentID == nil || !known[*c.ParentID] {
+			roots = append(roots, c)
+			continue
+		}
+		children[*c.ParentID] = append(children[*c.ParentID], c)
+	}
+
+	items := make(map[uuid.UUID][]domain.FAQ)
+	uncategorized := make([]domain.FAQ, 0)
+	for _, faq := range faqs {
+		if faq.CategoryID == nil || !known[*faq.CategoryID] {
+			uncategorized = append(uncategorized, faq)
+			continue
+		}
+		items[*faq.CategoryID] = append(items[*faq.CategoryID], faq)
+	}
+
+	var build func(cs []domain.Category) []domain.FAQGroup
+	build = func(cs []domain.Category) []domain.FAQGroup {
+		out := make([]domain.FAQGroup, 0, len(cs))
+		for _, c := range cs {
+			group := domain.FAQGroup{
+				Category: &c,
+				Items:    items[c.ID],
+				Children: build(children[c.ID]),
+			}
+			if len(group.Items) == 0 && len(group.Children) == 0 {
+				continue
+			}
+			if group.Items == nil {
+				group.Items = []domain.FAQ{}
+			}
+			out = append(out, group)
+		}
+		return out
+	}
+
+	out := build(roots)
+	if len(uncategorized) > 0 {
+		out = append(out, domain.FAQGroup{Items: uncategorized})
+	}
+	return out, nil
+}
+
+// checkParent makes sure the parent exists and is not the category itself
+// or one of its descendants.
+func (s *CategoryService) checkParent(ctx context.Context, id, parentID uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool)
+	current := &parentID
+	for current != nil {
+		if *current == id {
+			return domain.ValidationError{Message: "category cannot be nested into itself"}
+		}
+		if seen[*current] {
+			break
+		}
+		seen[*current] = true
+
+		parent, err := s.repo.GetByID(ctx, *current)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.ValidationError{Message: "parent category not found"}
+			}
+			return err
+		}
+		current = parent.ParentID
+	}
+	return nil
+}
+
+func validateCategoryInput(name, slug string, position int) error {
+	if strings.TrimSpace(name) == "" {
+		return domain.ValidationError{Message: "name is required"}
+	}
+	if !slugPattern.MatchString(slug) {
+		return domain.ValidationError{Message: "slug must contain lowercase letters, digits and dashes"}
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
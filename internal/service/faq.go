@@ -3,28 +3,129 @@ package service
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nightmaker00/accordion-go/internal/domain"
 )
 
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	maxSearchQueryLen  = 256
+)
+
+// searchLanguages maps accepted language names and codes to the text
+// search configurations indexed by the storage.
+var searchLanguages = map[string]string{
+	"simple":  "simple",
+	"en":      "english",
+	"english": "english",
+	"ru":      "russian",
+	"russian": "russian",
+}
+
 type FAQService struct {
-	repo FAQRepository
+	repo            FAQRepository
+	searchLanguage  string
+	defaultLocale   string
+	localeFallbacks []string
+	trashRetention  time.Duration
+	now             func() time.Time
+}
+
+type FAQOption func(*FAQService)
+
+// WithSearchLanguage sets the language used by Search when a query
+// does not specify one. Unknown languages are ignored.
+func WithSearchLanguage(lang string) FAQOption {
+	return func(s *FAQService) {
+		if config, ok := searchLanguages[strings.ToLower(lang)]; ok {
+			s.searchLanguage = config
+		}
+	}
 }
 
-func NewFAQService(repo FAQRepository) *FAQService {
-	return &FAQService{repo: repo}
+// WithDefaultLocale sets the locale of the content stored in the FAQ
+// itself. Translations are kept for all other locales.
+func WithDefaultLocale(locale string) FAQOption {
+	return func(s *FAQService) {
+		if normalized, ok := domain.NormalizeLocale(locale); ok {
+			s.defaultLocale = normalized
+		}
+	}
+}
+
+// WithLocaleFallbacks sets locales tried, in order, when none of the
+// requested locales has a translation.
+func WithLocaleFallbacks(locales ...string) FAQOption {
+	return func(s *FAQService) {
+		s.localeFallbacks = s.localeFallbacks[:0]
+		for _, locale := range locales {
+			if normalized, ok := domain.NormalizeLocale(locale); ok {
+				s.localeFallbacks = append(s.localeFallbacks, normalized)
+			}
+		}
+	}
+}
+
+// WithClock sets the source of the current time used to check publish
+// and expire windows.
+func WithClock(now func() time.Time) FAQOption {
+	return func(s *FAQService) {
+		if now != nil {
+			s.now = now
+		}
+	}
 }
 
-func (s *FAQService) ListActive(ctx context.Context) ([]domain.FAQ, error) {
-	items, err := s.repo.ListActive(ctx)
+// WithTrashRetention sets how long deleted FAQs stay in the trash before
+// PurgeExpired removes them. Zero keeps them until purged manually.
+func WithTrashRetention(d time.Duration) FAQOption {
+	return func(s *FAQService) {
+		s.trashRetention = d
+	}
+}
+
+func NewFAQService(repo FAQRepository, opts ...FAQOption) *FAQService {
+	s := &FAQService{repo: repo, searchLanguage: "simple", defaultLocale: "en", now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *FAQService) ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error) {
+	if filter.CategoryID != nil && *filter.CategoryID == uuid.Nil {
+		return domain.ActiveFAQs{}, domain.ValidationError{Message: "category_id is invalid"}
+	}
+	filter.At = s.now()
+	out, err := s.repo.ListActive(ctx, filter)
 	if err != nil {
-		return nil, err
+		return domain.ActiveFAQs{}, err
+	}
+	if out.Items, err = s.localize(ctx, out.Items, filter.Locales); err != nil {
+		return domain.ActiveFAQs{}, err
 	}
-	return items, nil
+	return out, nil
 }
 
+// GetByID returns a FAQ by ID. FAQs outside their publish/expire window
+// are reported as not found.
 func (s *FAQService) GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	out, err := s.get(ctx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if !out.Scheduled(s.now()) {
+		return domain.FAQ{}, domain.ErrNotFound
+	}
+	return out, nil
+}
+
+// get returns a FAQ regardless of its schedule. It is used by editing
+// operations, which must reach FAQs that are not visible yet.
+func (s *FAQService) get(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
 	if id == uuid.Nil {
 		return domain.FAQ{}, domain.ValidationError{Message: "id is required"}
 	}
@@ -32,53 +133,195 @@ func (s *FAQService) GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, err
 	if err != nil {
 		return domain.FAQ{}, err
 	}
+	out.Locale = s.defaultLocale
 	return out, nil
 }
 
+// GetLocalized returns a FAQ with its content in the best matching locale.
+func (s *FAQService) GetLocalized(ctx context.Context, id uuid.UUID, locales []string) (domain.FAQ, error) {
+	out, err := s.GetByID(ctx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	items, err := s.localize(ctx, []domain.FAQ{out}, locales)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	return items[0], nil
+}
+
 func (s *FAQService) Create(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error) {
-	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+	if err := s.prepareCreate(ctx, &in); err != nil {
 		return domain.FAQ{}, err
 	}
 	out, err := s.repo.Create(ctx, in)
 	if err != nil {
 		return domain.FAQ{}, err
 	}
+	out.Locale = s.defaultLocale
 	return out, nil
 }
 
 func (s *FAQService) Update(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error) {
-	if id == uuid.Nil {
-		return domain.FAQ{}, domain.ValidationError{Message: "id is required"}
-	}
-	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+	if err := s.prepareUpdate(ctx, id, in); err != nil {
 		return domain.FAQ{}, err
 	}
 	out, err := s.repo.Update(ctx, id, in)
 	if err != nil {
 		return domain.FAQ{}, err
 	}
+	out.Locale = s.defaultLocale
 	return out, nil
 }
 
 func (s *FAQService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.prepareDelete(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// prepareCreate checks a create and fills in the default status.
+func (s *FAQService) prepareCreate(ctx context.Context, in *domain.CreateFAQInput) error {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+		return err
+	}
+	if err := validateSchedule(in.PublishAt, in.ExpireAt); err != nil {
+		return err
+	}
+	if err := validateContentFormat(in.ContentFormat); err != nil {
+		return err
+	}
+	if in.ContentFormat == "" {
+		in.ContentFormat = domain.ContentPlain
+	}
+	switch in.Status {
+	case "":
+		in.Status = domain.StatusPublished
+	case domain.StatusDraft, domain.StatusInReview, domain.StatusPublished:
+	default:
+		return domain.ValidationError{Message: "status must be draft, in_review or published"}
+	}
+	if in.Status == domain.StatusPublished {
+		return authorize(ctx, domain.RolePublisher)
+	}
+	return nil
+}
+
+// prepareUpdate checks an update. The current FAQ is only read when the
+// caller's role depends on it.
+func (s *FAQService) prepareUpdate(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) error {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
 	if id == uuid.Nil {
 		return domain.ValidationError{Message: "id is required"}
 	}
-	if err := s.repo.Delete(ctx, id); err != nil {
+	if err := validateFAQInput(in.Title, in.Content, in.Position); err != nil {
+		return err
+	}
+	if err := validateSchedule(in.PublishAt, in.ExpireAt); err != nil {
 		return err
 	}
+	if err := validateContentFormat(in.ContentFormat); err != nil {
+		return err
+	}
+	if hasRole(ctx, domain.RolePublisher) {
+		return nil
+	}
+	current, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return authorizeEdit(ctx, current, in.IsActive != current.IsActive)
+}
+
+// prepareDelete checks a delete.
+func (s *FAQService) prepareDelete(ctx context.Context, id uuid.UUID) error {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return err
+	}
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
 	return nil
 }
 
+func (s *FAQService) Search(ctx context.Context, in domain.SearchQuery) ([]domain.SearchResult, error) {
+	in.Query = strings.TrimSpace(in.Query)
+	if in.Query == "" {
+		return nil, domain.ValidationError{Message: "q is required"}
+	}
+	if len(in.Query) > maxSearchQueryLen {
+		return nil, domain.ValidationError{Message: "q is too long"}
+	}
+	if in.CategoryID != nil && *in.CategoryID == uuid.Nil {
+		return nil, domain.ValidationError{Message: "category_id is invalid"}
+	}
+
+	if in.Language == "" {
+		in.Language = s.searchLanguage
+	} else {
+		config, ok := searchLanguages[strings.ToLower(in.Language)]
+		if !ok {
+			return nil, domain.ValidationError{Message: "unsupported language"}
+		}
+		in.Language = config
+	}
+
+	switch {
+	case in.Limit == 0:
+		in.Limit = defaultSearchLimit
+	case in.Limit < 0 || in.Limit > maxSearchLimit:
+		return nil, domain.ValidationError{Message: "limit must be between 1 and 100"}
+	}
+
+	in.At = s.now()
+	out, err := s.repo.Search(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	for i := range out {
+		out[i].FAQ.Locale = s.defaultLocale
+	}
+	return out, nil
+}
+
 func validateFAQInput(title, content string, position int) error {
+	if err := validateContent(title, content); err != nil {
+		return err
+	}
+	if position <= 0 {
+		return domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	return nil
+}
+
+// validateContentFormat accepts an empty format, which stands for the
+// default or the current one.
+func validateContentFormat(format domain.ContentFormat) error {
+	if format != "" && !format.Valid() {
+		return domain.ValidationError{Message: "content_format must be plain, markdown or html"}
+	}
+	return nil
+}
+
+func validateSchedule(publishAt, expireAt *time.Time) error {
+	if publishAt != nil && expireAt != nil && !expireAt.After(*publishAt) {
+		return domain.ValidationError{Message: "expire_at must be after publish_at"}
+	}
+	return nil
+}
+
+func validateContent(title, content string) error {
 	if strings.TrimSpace(title) == "" {
 		return domain.ValidationError{Message: "title is required"}
 	}
 	if strings.TrimSpace(content) == "" {
 		return domain.ValidationError{Message: "content is required"}
 	}
-	if position <= 0 {
-		return domain.ValidationError{Message: "position must be greater than 0"}
-	}
 	return nil
 }
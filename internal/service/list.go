@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// List returns a page of all FAQs, active or not, for administration.
+// The cursor of the next page is set only when more FAQs follow.
+func (s *FAQService) List(ctx context.Context, filter domain.FAQListFilter, cursor string) (domain.FAQPage, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return domain.FAQPage{}, err
+	}
+	if filter.Sort == "" {
+		filter.Sort = domain.SortByPosition
+	}
+	if !filter.Sort.Valid() {
+		return domain.FAQPage{}, domain.ValidationError{Message: "sort must be position, created_at, updated_at or title"}
+	}
+	if filter.Status != "" && !filter.Status.Valid() {
+		return domain.FAQPage{}, domain.ValidationError{Message: "status is invalid"}
+	}
+	if filter.CategoryID != nil && *filter.CategoryID == uuid.Nil {
+		return domain.FAQPage{}, domain.ValidationError{Message: "category_id is invalid"}
+	}
+	filter.Query = strings.TrimSpace(filter.Query)
+	if len(filter.Query) > maxSearchQueryLen {
+		return domain.FAQPage{}, domain.ValidationError{Message: "q is too long"}
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultListLimit
+	case filter.Limit < 0 || filter.Limit > maxListLimit:
+		return domain.FAQPage{}, domain.ValidationError{Message: "limit must be between 1 and 100"}
+	}
+
+	if cursor != "" {
+		after, err := domain.DecodeFAQCursor(cursor)
+		if err != nil {
+			return domain.FAQPage{}, domain.ValidationError{Message: "cursor is invalid"}
+		}
+		if after.Sort != filter.Sort || after.Desc != filter.Desc {
+			return domain.FAQPage{}, domain.ValidationError{Message: "cursor does not match sort order"}
+		}
+		filter.After = &after
+	}
+
+	// one extra row tells whether there is a next page
+	limit := filter.Limit
+	filter.Limit++
+	items, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return domain.FAQPage{}, err
+	}
+
+	page := domain.FAQPage{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = domain.NewFAQCursor(filter.Sort, filter.Desc, page.Items[limit-1]).Encode()
+	}
+	for i := range page.Items {
+		page.Items[i].Locale = s.defaultLocale
+	}
+	return page, nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Reorder rewrites positions of the FAQs of a category in one step. The
+// list must contain every FAQ of the category.
+func (s *FAQService) Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return nil, err
+	}
+	if in.CategoryID != nil && *in.CategoryID == uuid.Nil {
+		return nil, domain.ValidationError{Message: "category_id is invalid"}
+	}
+	if len(in.IDs) == 0 {
+		return nil, domain.ValidationError{Message: "ids are required"}
+	}
+	seen := make(map[uuid.UUID]struct{}, len(in.IDs))
+	for _, id := range in.IDs {
+		if id == uuid.Nil {
+			return nil, domain.ValidationError{Message: "ids must not contain empty values"}
+		}
+		if _, ok := seen[id]; ok {
+			return nil, domain.ValidationError{Message: "ids must not contain duplicates"}
+		}
+		seen[id] = struct{}{}
+	}
+	if !hasRole(ctx, domain.RolePublisher) {
+		if err := s.authorizeOrder(ctx, in.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := s.repo.Reorder(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Locale = s.defaultLocale
+	}
+	return items, nil
+}
+
+// Move places a FAQ right before or after another FAQ of its category.
+func (s *FAQService) Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return nil, err
+	}
+	if in.ID == uuid.Nil {
+		return nil, domain.ValidationError{Message: "id is required"}
+	}
+	if in.Target == uuid.Nil {
+		return nil, domain.ValidationError{Message: "before or after is required"}
+	}
+	if in.Target == in.ID {
+		return nil, domain.ValidationError{Message: "faq cannot be moved relative to itself"}
+	}
+	if !hasRole(ctx, domain.RolePublisher) {
+		current, err := s.get(ctx, in.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authorizeOrder(ctx, current.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := s.repo.Move(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Locale = s.defaultLocale
+	}
+	return items, nil
+}
+
+// authorizeOrder checks a change of the order of a category for an editor.
+// Positions are renumbered across the whole category, so, as with a direct
+// edit of a position, none of its FAQs may have been live.
+func (s *FAQService) authorizeOrder(ctx context.Context, categoryID *uuid.UUID) error {
+	items, err := s.repo.List(ctx, domain.FAQListFilter{CategoryID: categoryID, Sort: domain.SortByPosition})
+	if err != nil {
+		return err
+	}
+	for _, it := range items {
+		// without a category the filter matches every FAQ
+		if !equalUUIDPtr(it.CategoryID, categoryID) {
+			continue
+		}
+		if err := authorizeEdit(ctx, it, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// Patch applies a partial update. Fields missing from the patch keep
+// their current values.
+func (s *FAQService) Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return domain.FAQ{}, err
+	}
+	if id == uuid.Nil {
+		return domain.FAQ{}, domain.ValidationError{Message: "id is required"}
+	}
+	if patch.Empty() {
+		return domain.FAQ{}, domain.ValidationError{Message: "patch does not change any field"}
+	}
+	if patch.CategoryID.Set && patch.CategoryID.Value != nil && *patch.CategoryID.Value == uuid.Nil {
+		return domain.FAQ{}, domain.ValidationError{Message: "category_id is invalid"}
+	}
+	if patch.Title.Set && strings.TrimSpace(patch.Title.Value) == "" {
+		return domain.FAQ{}, domain.ValidationError{Message: "title is required"}
+	}
+	if patch.Content.Set && strings.TrimSpace(patch.Content.Value) == "" {
+		return domain.FAQ{}, domain.ValidationError{Message: "content is required"}
+	}
+	if patch.ContentFormat.Set && !patch.ContentFormat.Value.Valid() {
+		return domain.FAQ{}, domain.ValidationError{Message: "content_format must be plain, markdown or html"}
+	}
+	if patch.Position.Set && patch.Position.Value <= 0 {
+		return domain.FAQ{}, domain.ValidationError{Message: "position must be greater than 0"}
+	}
+	if patch.PublishAt.Set && patch.ExpireAt.Set {
+		if err := validateSchedule(patch.PublishAt.Value, patch.ExpireAt.Value); err != nil {
+			return domain.FAQ{}, err
+		}
+	}
+
+	if !hasRole(ctx, domain.RolePublisher) {
+		current, err := s.get(ctx, id)
+		if err != nil {
+			return domain.FAQ{}, err
+		}
+		togglesActive := patch.IsActive.Set && patch.IsActive.Value != current.IsActive
+		if err := authorizeEdit(ctx, current, togglesActive); err != nil {
+			return domain.FAQ{}, err
+		}
+	}
+
+	out, err := s.repo.Patch(ctx, id, patch)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out.Locale = s.defaultLocale
+	return out, nil
+}
@@ -2,15 +2,63 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nightmaker00/accordion-go/internal/domain"
 )
 
 type FAQRepository interface {
-	ListActive(ctx context.Context) ([]domain.FAQ, error)
+	ListActive(ctx context.Context, filter domain.ListActiveFilter) (domain.ActiveFAQs, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.FAQ, error)
+	List(ctx context.Context, filter domain.FAQListFilter) ([]domain.FAQ, error)
 	Create(ctx context.Context, in domain.CreateFAQInput) (domain.FAQ, error)
 	Update(ctx context.Context, id uuid.UUID, in domain.UpdateFAQInput) (domain.FAQ, error)
+	Patch(ctx context.Context, id uuid.UUID, patch domain.FAQPatch) (domain.FAQ, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Search(ctx context.Context, in domain.SearchQuery) ([]domain.SearchResult, error)
+
+	ListTranslations(ctx context.Context, faqID uuid.UUID) ([]domain.Translation, error)
+	FindTranslations(ctx context.Context, faqIDs []uuid.UUID, locales []string) ([]domain.Translation, error)
+	UpsertTranslation(ctx context.Context, in domain.UpsertTranslationInput) (domain.Translation, error)
+	DeleteTranslation(ctx context.Context, faqID uuid.UUID, locale string) error
+	ListMissingTranslations(ctx context.Context, locale string) ([]domain.FAQ, error)
+
+	ListRevisions(ctx context.Context, faqID uuid.UUID) ([]domain.Revision, error)
+	GetRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.Revision, error)
+	RestoreRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.FAQ, error)
+
+	SaveDraft(ctx context.Context, id uuid.UUID, draft domain.FAQDraft) (domain.FAQ, error)
+	ChangeStatus(ctx context.Context, id uuid.UUID, from, to domain.FAQStatus) (domain.FAQ, error)
+
+	ListDeleted(ctx context.Context) ([]domain.FAQ, error)
+	Restore(ctx context.Context, id uuid.UUID) (domain.FAQ, error)
+	Purge(ctx context.Context, id uuid.UUID) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+
+	Reorder(ctx context.Context, in domain.ReorderInput) ([]domain.FAQ, error)
+	Move(ctx context.Context, in domain.MoveInput) ([]domain.FAQ, error)
+
+	Import(ctx context.Context, in domain.ImportInput) (domain.ImportResult, error)
+	Batch(ctx context.Context, ops []domain.BatchOperation) ([]domain.FAQ, error)
+}
+
+type CategoryRepository interface {
+	List(ctx context.Context) ([]domain.Category, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error)
+	Create(ctx context.Context, in domain.CreateCategoryInput) (domain.Category, error)
+	Update(ctx context.Context, id uuid.UUID, in domain.UpdateCategoryInput) (domain.Category, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type APIKeyRepository interface {
+	List(ctx context.Context) ([]domain.APIKey, error)
+	GetByHash(ctx context.Context, hash string) (domain.APIKey, error)
+	Create(ctx context.Context, in domain.CreateAPIKeyInput) (domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
+
+type AuditRepository interface {
+	Append(ctx context.Context, in domain.CreateAuditEventInput) (domain.AuditEvent, error)
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error)
 }
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (s *FAQService) ListRevisions(ctx context.Context, faqID uuid.UUID) ([]domain.Revision, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return nil, err
+	}
+	if faqID == uuid.Nil {
+		return nil, domain.ValidationError{Message: "id is required"}
+	}
+	items, err := s.repo.ListRevisions(ctx, faqID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return items, nil
+}
+
+func (s *FAQService) GetRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.Revision, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return domain.Revision{}, err
+	}
+	if err := validateRevisionRef(faqID, number); err != nil {
+		return domain.Revision{}, err
+	}
+	out, err := s.repo.GetRevision(ctx, faqID, number)
+	if err != nil {
+		return domain.Revision{}, err
+	}
+	return out, nil
+}
+
+// DiffRevisions lists the fields that differ between two revisions.
+func (s *FAQService) DiffRevisions(ctx context.Context, faqID uuid.UUID, from, to int) (domain.RevisionDiff, error) {
+	older, err := s.GetRevision(ctx, faqID, from)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+	newer, err := s.GetRevision(ctx, faqID, to)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+
+	a, b := older.Snapshot, newer.Snapshot
+	changes := make([]domain.RevisionChange, 0)
+	if !equalUUIDPtr(a.CategoryID, b.CategoryID) {
+		changes = append(changes, domain.RevisionChange{Field: "category_id", From: a.CategoryID, To: b.CategoryID})
+	}
+	if a.Title != b.Title {
+		changes = append(changes, domain.RevisionChange{Field: "title", From: a.Title, To: b.Title})
+	}
+	if a.Content != b.Content {
+		changes = append(changes, domain.RevisionChange{Field: "content", From: a.Content, To: b.Content})
+	}
+	if a.ContentFormat != b.ContentFormat {
+		changes = append(changes, domain.RevisionChange{Field: "content_format", From: a.ContentFormat, To: b.ContentFormat})
+	}
+	if a.Position != b.Position {
+		changes = append(changes, domain.RevisionChange{Field: "position", From: a.Position, To: b.Position})
+	}
+	if a.IsActive != b.IsActive {
+		changes = append(changes, domain.RevisionChange{Field: "is_active", From: a.IsActive, To: b.IsActive})
+	}
+	if a.Status != b.Status {
+		changes = append(changes, domain.RevisionChange{Field: "status", From: a.Status, To: b.Status})
+	}
+	if !equalDraft(a.Draft, b.Draft) {
+		changes = append(changes, domain.RevisionChange{Field: "draft", From: a.Draft, To: b.Draft})
+	}
+	if !equalTimePtr(a.PublishAt, b.PublishAt) {
+		changes = append(changes, domain.RevisionChange{Field: "publish_at", From: a.PublishAt, To: b.PublishAt})
+	}
+	if !equalTimePtr(a.ExpireAt, b.ExpireAt) {
+		changes = append(changes, domain.RevisionChange{Field: "expire_at", From: a.ExpireAt, To: b.ExpireAt})
+	}
+
+	return domain.RevisionDiff{FAQID: faqID, From: from, To: to, Changes: changes}, nil
+}
+
+// RestoreRevision makes an old revision the current version of the FAQ.
+// The restore itself is recorded as a new revision.
+func (s *FAQService) RestoreRevision(ctx context.Context, faqID uuid.UUID, number int) (domain.FAQ, error) {
+	if err := authorize(ctx, domain.RolePublisher); err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := validateRevisionRef(faqID, number); err != nil {
+		return domain.FAQ{}, err
+	}
+	out, err := s.repo.RestoreRevision(ctx, faqID, number)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out.Locale = s.defaultLocale
+	return out, nil
+}
+
+func validateRevisionRef(faqID uuid.UUID, number int) error {
+	if faqID == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	if number <= 0 {
+		return domain.ValidationError{Message: "revision must be greater than 0"}
+	}
+	return nil
+}
+
+func equalUUIDPtr(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalDraft(a, b *domain.FAQDraft) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
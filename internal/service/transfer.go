@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// maxImportItems bounds the size of a single import.
+const maxImportItems = 5000
+
+// Export returns every FAQ outside the trash, including inactive and
+// unpublished ones, ordered by position.
+func (s *FAQService) Export(ctx context.Context) ([]domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return nil, err
+	}
+	items, err := s.repo.List(ctx, domain.FAQListFilter{Sort: domain.SortByPosition})
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Locale = s.defaultLocale
+	}
+	return items, nil
+}
+
+// Import stores the FAQs of a file in one transaction. Every row is
+// checked first and all faulty rows are reported together; nothing is
+// stored unless the whole file is valid.
+func (s *FAQService) Import(ctx context.Context, in domain.ImportInput) (domain.ImportResult, error) {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return domain.ImportResult{}, err
+	}
+	if in.Strategy == "" {
+		in.Strategy = domain.ImportUpsert
+	}
+	if !in.Strategy.Valid() {
+		return domain.ImportResult{}, domain.ValidationError{Message: "strategy must be upsert or replace"}
+	}
+	// an empty file would move every FAQ to the trash, which is far more
+	// likely a broken export than the intent
+	if in.Strategy == domain.ImportReplace && len(in.Items) == 0 {
+		return domain.ImportResult{}, domain.ValidationError{Message: "replace needs at least one faq, use the trash to remove them all"}
+	}
+	if len(in.Items) > maxImportItems {
+		return domain.ImportResult{}, domain.ValidationError{Message: fmt.Sprintf("import is limited to %d faqs", maxImportItems)}
+	}
+
+	var result domain.ImportResult
+	seen := make(map[uuid.UUID]int, len(in.Items))
+	for i := range in.Items {
+		it := &in.Items[i]
+		if it.Status == "" {
+			it.Status = domain.StatusPublished
+		}
+		if err := validateImportItem(*it, seen); err != nil {
+			result.Errors = append(result.Errors, domain.ImportError{Row: it.Row, Message: err.Error()})
+		}
+		if it.ID != nil {
+			seen[*it.ID] = it.Row
+		}
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	return s.repo.Import(ctx, in)
+}
+
+func validateImportItem(it domain.ImportItem, seen map[uuid.UUID]int) error {
+	if it.ID != nil {
+		if *it.ID == uuid.Nil {
+			return domain.ValidationError{Message: "id is invalid"}
+		}
+		if row, ok := seen[*it.ID]; ok {
+			return domain.ValidationError{Message: fmt.Sprintf("id is already used in row %d", row)}
+		}
+	}
+	if it.CategoryID != nil && *it.CategoryID == uuid.Nil {
+		return domain.ValidationError{Message: "category_id is invalid"}
+	}
+	if err := validateFAQInput(it.Title, it.Content, it.Position); err != nil {
+		return err
+	}
+	if err := validateSchedule(it.PublishAt, it.ExpireAt); err != nil {
+		return err
+	}
+	if err := validateContentFormat(it.ContentFormat); err != nil {
+		return err
+	}
+	if !it.Status.Valid() {
+		return domain.ValidationError{Message: "status must be draft, in_review, published or archived"}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nightmaker00/accordion-go/internal/domain"
+	"github.com/nightmaker00/accordion-go/internal/repository/memory"
+	"github.com/nightmaker00/accordion-go/internal/service"
+)
+
+func TestImportEmpty(t *testing.T) {
+	faqs := service.NewFAQService(memory.NewFAQRepository(memory.NewStore()))
+	ctx := as(domain.RoleAdmin)
+	if _, err := faqs.Create(ctx, domain.CreateFAQInput{Title: "Shipping", Content: "C", Position: 1, IsActive: true}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		in      domain.ImportInput
+		wantErr bool
+	}{
+		{"replace", domain.ImportInput{Strategy: domain.ImportReplace}, true},
+		{"replace dry run", domain.ImportInput{Strategy: domain.ImportReplace, DryRun: true}, true},
+		{"upsert", domain.ImportInput{Strategy: domain.ImportUpsert}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := faqs.Import(ctx, tt.in)
+			var ve domain.ValidationError
+			if tt.wantErr != errors.As(err, &ve) {
+				t.Fatalf("err = %v, want validation error %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && (err != nil || len(result.Changes) != 0) {
+				t.Errorf("result = %+v, %v", result, err)
+			}
+		})
+	}
+
+	items, err := faqs.Export(ctx)
+	if err != nil || len(items) != 1 {
+		t.Errorf("export after empty imports = %+v, %v", items, err)
+	}
+}
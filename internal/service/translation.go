@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+func (s *FAQService) ListTranslations(ctx context.Context, faqID uuid.UUID) ([]domain.Translation, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return nil, err
+	}
+	if _, err := s.get(ctx, faqID); err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListTranslations(ctx, faqID)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *FAQService) UpsertTranslation(ctx context.Context, in domain.UpsertTranslationInput) (domain.Translation, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return domain.Translation{}, err
+	}
+	if in.FAQID == uuid.Nil {
+		return domain.Translation{}, domain.ValidationError{Message: "id is required"}
+	}
+	locale, err := s.translationLocale(in.Locale)
+	if err != nil {
+		return domain.Translation{}, err
+	}
+	in.Locale = locale
+	if err := validateContent(in.Title, in.Content); err != nil {
+		return domain.Translation{}, err
+	}
+	out, err := s.repo.UpsertTranslation(ctx, in)
+	if err != nil {
+		return domain.Translation{}, err
+	}
+	return out, nil
+}
+
+func (s *FAQService) DeleteTranslation(ctx context.Context, faqID uuid.UUID, locale string) error {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+	if faqID == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	locale, err := s.translationLocale(locale)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteTranslation(ctx, faqID, locale); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListMissingTranslations reports FAQs, active or not, that have no
+// translation for the locale.
+func (s *FAQService) ListMissingTranslations(ctx context.Context, locale string) ([]domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return nil, err
+	}
+	locale, err := s.translationLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListMissingTranslations(ctx, locale)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Locale = s.defaultLocale
+	}
+	return items, nil
+}
+
+func (s *FAQService) translationLocale(raw string) (string, error) {
+	locale, ok := domain.NormalizeLocale(raw)
+	if !ok {
+		return "", domain.ValidationError{Message: "locale is invalid"}
+	}
+	if locale == s.defaultLocale {
+		return "", domain.ValidationError{Message: "content in the default locale is stored in the FAQ itself"}
+	}
+	return locale, nil
+}
+
+// localeChain expands requested locales with their base languages and the
+// configured fallbacks. The chain ends at the default locale, since the
+// FAQ's own content is used from there on.
+func (s *FAQService) localeChain(requested []string) []string {
+	candidates := make([]string, 0, len(requested)*2+len(s.localeFallbacks))
+	for _, raw := range requested {
+		locale, ok := domain.NormalizeLocale(raw)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, locale)
+		if base := domain.BaseLocale(locale); base != locale {
+			candidates = append(candidates, base)
+		}
+	}
+	candidates = append(candidates, s.localeFallbacks...)
+
+	seen := make(map[string]bool, len(candidates))
+	chain := make([]string, 0, len(candidates))
+	for _, locale := range candidates {
+		if locale == s.defaultLocale {
+			break
+		}
+		if seen[locale] {
+			continue
+		}
+		seen[locale] = true
+		chain = append(chain, locale)
+	}
+	return chain
+}
+
+// localize replaces title and content with the best translation from the
+// locale chain, keeping the default locale content when none matches.
+func (s *FAQService) localize(ctx context.Context, items []domain.FAQ, requested []string) ([]domain.FAQ, error) {
+	for i := range items {
+		items[i].Locale = s.defaultLocale
+	}
+
+	chain := s.localeChain(requested)
+	if len(chain) == 0 || len(items) == 0 {
+		return items, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.ID)
+	}
+	translations, err := s.repo.FindTranslations(ctx, ids, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	byFAQ := make(map[uuid.UUID]map[string]domain.Translation, len(items))
+	for _, t := range translations {
+		if byFAQ[t.FAQID] == nil {
+			byFAQ[t.FAQID] = make(map[string]domain.Translation)
+		}
+		byFAQ[t.FAQID][t.Locale] = t
+	}
+
+	for i := range items {
+		for _, locale := range chain {
+			t, ok := byFAQ[items[i].ID][locale]
+			if !ok {
+				continue
+			}
+			items[i].Locale = t.Locale
+			items[i].Title = t.Title
+			items[i].Content = t.Content
+			break
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// ListDeleted returns FAQs in the trash, most recently deleted first.
+func (s *FAQService) ListDeleted(ctx context.Context) ([]domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleViewer); err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Locale = s.defaultLocale
+	}
+	return items, nil
+}
+
+// Restore takes a FAQ out of the trash.
+func (s *FAQService) Restore(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return domain.FAQ{}, err
+	}
+	if id == uuid.Nil {
+		return domain.FAQ{}, domain.ValidationError{Message: "id is required"}
+	}
+	out, err := s.repo.Restore(ctx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out.Locale = s.defaultLocale
+	return out, nil
+}
+
+// Purge permanently removes a FAQ from the trash. Its revision history is
+// kept, so it can still be recreated from a revision.
+func (s *FAQService) Purge(ctx context.Context, id uuid.UUID) error {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return err
+	}
+	if id == uuid.Nil {
+		return domain.ValidationError{Message: "id is required"}
+	}
+	return s.repo.Purge(ctx, id)
+}
+
+// EmptyTrash permanently removes every FAQ in the trash.
+func (s *FAQService) EmptyTrash(ctx context.Context) (int, error) {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return 0, err
+	}
+	return s.repo.PurgeDeleted(ctx, s.now())
+}
+
+// PurgeExpired permanently removes FAQs that have been in the trash for
+// longer than the retention period. It does nothing when retention is
+// disabled.
+func (s *FAQService) PurgeExpired(ctx context.Context) (int, error) {
+	if err := authorize(ctx, domain.RoleAdmin); err != nil {
+		return 0, err
+	}
+	if s.trashRetention <= 0 {
+		return 0, nil
+	}
+	return s.repo.PurgeDeleted(ctx, s.now().Add(-s.trashRetention))
+}
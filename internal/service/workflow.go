@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nightmaker00/accordion-go/internal/domain"
+)
+
+// statusTransitions lists the allowed workflow moves. Content is edited
+// in draft, reviewed, then published; publishing is only possible after
+// review.
+var statusTransitions = map[domain.FAQStatus][]domain.FAQStatus{
+	domain.StatusDraft:     {domain.StatusInReview, domain.StatusArchived},
+	domain.StatusInReview:  {domain.StatusDraft, domain.StatusPublished, domain.StatusArchived},
+	domain.StatusPublished: {domain.StatusDraft, domain.StatusArchived},
+	domain.StatusArchived:  {domain.StatusDraft},
+}
+
+// SaveDraft stores the next version of a FAQ next to its live content and
+// moves it back to draft. Published content keeps being served meanwhile.
+func (s *FAQService) SaveDraft(ctx context.Context, id uuid.UUID, draft domain.FAQDraft) (domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return domain.FAQ{}, err
+	}
+	current, err := s.get(ctx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if err := validateContent(draft.Title, draft.Content); err != nil {
+		return domain.FAQ{}, err
+	}
+	if current.Status == domain.StatusArchived {
+		return domain.FAQ{}, domain.ValidationError{Message: "archived faq cannot be edited, move it to draft first"}
+	}
+	out, err := s.repo.SaveDraft(ctx, id, draft)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out.Locale = s.defaultLocale
+	return out, nil
+}
+
+// ChangeStatus moves a FAQ through the workflow. Moving to published
+// atomically replaces the live content with the draft.
+func (s *FAQService) ChangeStatus(ctx context.Context, id uuid.UUID, to domain.FAQStatus) (domain.FAQ, error) {
+	if err := authorize(ctx, domain.RoleEditor); err != nil {
+		return domain.FAQ{}, err
+	}
+	if !to.Valid() {
+		return domain.FAQ{}, domain.ValidationError{Message: "status is invalid"}
+	}
+	current, err := s.get(ctx, id)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	if !canTransition(current.Status, to) {
+		return domain.FAQ{}, domain.ValidationError{
+			Message: fmt.Sprintf("cannot move faq from %s to %s", current.Status, to),
+		}
+	}
+	if changesLiveContent(current.Status, to) {
+		if err := authorize(ctx, domain.RolePublisher); err != nil {
+			return domain.FAQ{}, err
+		}
+	}
+	out, err := s.repo.ChangeStatus(ctx, id, current.Status, to)
+	if err != nil {
+		return domain.FAQ{}, err
+	}
+	out.Locale = s.defaultLocale
+	return out, nil
+}
+
+func (s *FAQService) Publish(ctx context.Context, id uuid.UUID) (domain.FAQ, error) {
+	return s.ChangeStatus(ctx, id, domain.StatusPublished)
+}
+
+// changesLiveContent reports whether a transition publishes, archives or
+// revives a FAQ. Editors only move FAQs between draft and review.
+func changesLiveContent(from, to domain.FAQStatus) bool {
+	for _, status := range []domain.FAQStatus{from, to} {
+		if status == domain.StatusPublished || status == domain.StatusArchived {
+			return true
+		}
+	}
+	return false
+}
+
+func canTransition(from, to domain.FAQStatus) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
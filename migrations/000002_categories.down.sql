@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS faqs_category_position_idx;
+
+ALTER TABLE faqs DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    parent_id UUID REFERENCES categories (id) ON DELETE SET NULL,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL UNIQUE,
+    position INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS categories_parent_position_idx ON categories (parent_id, position);
+
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS faqs_category_position_idx ON faqs (category_id, position);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS faqs_search_vector_idx;
+
+ALTER TABLE faqs DROP COLUMN IF EXISTS search_vector;
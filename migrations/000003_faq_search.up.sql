@@ -0,0 +1,12 @@
+-- Lexemes for every supported stemmer are stored side by side, so a query
+-- parsed with any of them hits the same GIN index.
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple'::regconfig, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian'::regconfig, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('russian'::regconfig, coalesce(content, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS faqs_search_vector_idx ON faqs USING GIN (search_vector);
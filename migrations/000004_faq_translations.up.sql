@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS faq_translations (
+    faq_id UUID NOT NULL REFERENCES faqs (id) ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (faq_id, locale)
+);
+
+CREATE INDEX IF NOT EXISTS faq_translations_locale_idx ON faq_translations (locale);
@@ -0,0 +1,26 @@
+-- No foreign key to faqs: history must outlive the FAQ it describes.
+CREATE TABLE IF NOT EXISTS faq_revisions (
+    faq_id UUID NOT NULL,
+    revision INT NOT NULL,
+    action TEXT NOT NULL,
+    actor TEXT NOT NULL DEFAULT '',
+    snapshot JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (faq_id, revision)
+);
+
+INSERT INTO faq_revisions (faq_id, revision, action, actor, snapshot, created_at)
+SELECT id, 1, 'create', 'migration',
+       jsonb_build_object(
+           'id', id,
+           'category_id', category_id,
+           'title', title,
+           'content', content,
+           'position', position,
+           'is_active', is_active,
+           'created_at', created_at,
+           'updated_at', updated_at
+       ),
+       updated_at
+FROM faqs
+ON CONFLICT DO NOTHING;
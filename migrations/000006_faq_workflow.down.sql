@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS faqs_status_idx;
+
+ALTER TABLE faqs
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS draft_content,
+    DROP COLUMN IF EXISTS draft_title,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,10 @@
+ALTER TABLE faqs
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'in_review', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS draft_title TEXT,
+    ADD COLUMN IF NOT EXISTS draft_content TEXT,
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+UPDATE faqs SET published_at = created_at WHERE published_at IS NULL AND status = 'published';
+
+CREATE INDEX IF NOT EXISTS faqs_status_idx ON faqs (status);
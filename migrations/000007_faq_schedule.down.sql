@@ -0,0 +1,4 @@
+ALTER TABLE faqs
+    DROP CONSTRAINT IF EXISTS faqs_schedule_check,
+    DROP COLUMN IF EXISTS expire_at,
+    DROP COLUMN IF EXISTS publish_at;
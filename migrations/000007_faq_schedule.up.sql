@@ -0,0 +1,4 @@
+ALTER TABLE faqs
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ,
+    ADD CONSTRAINT faqs_schedule_check CHECK (publish_at IS NULL OR expire_at IS NULL OR expire_at > publish_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS faqs_deleted_at_idx;
+
+DELETE FROM faqs WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE faqs DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,3 @@
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS faqs_deleted_at_idx ON faqs (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS faqs_category_position_key;
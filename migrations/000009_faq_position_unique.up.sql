@@ -0,0 +1,13 @@
+-- close gaps and resolve duplicates before enforcing uniqueness
+UPDATE faqs f
+SET position = ordered.rn
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY category_id ORDER BY position, created_at) AS rn
+    FROM faqs
+    WHERE deleted_at IS NULL
+) ordered
+WHERE f.id = ordered.id AND f.position <> ordered.rn;
+
+CREATE UNIQUE INDEX IF NOT EXISTS faqs_category_position_key
+    ON faqs (category_id, position) NULLS NOT DISTINCT
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS faqs_updated_at_id_idx;
+DROP INDEX IF EXISTS faqs_created_at_id_idx;
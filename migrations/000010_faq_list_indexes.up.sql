@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS faqs_created_at_id_idx ON faqs (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS faqs_updated_at_id_idx ON faqs (updated_at, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,10 @@
+-- Only a SHA-256 hash of each key is stored; the key itself is shown once
+-- when it is created.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    key_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMPTZ,
+    CONSTRAINT api_keys_key_hash_key UNIQUE (key_hash)
+);
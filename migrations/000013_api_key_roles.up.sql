@@ -0,0 +1,5 @@
+-- Keys created before roles existed could do everything.
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'admin';
+ALTER TABLE api_keys ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE api_keys ADD CONSTRAINT api_keys_role_check
+    CHECK (role IN ('viewer', 'editor', 'publisher', 'admin'));
@@ -0,0 +1,19 @@
+-- No foreign key to faqs: the log must outlive the FAQ it describes.
+-- Events of a translation name its locale, events of the FAQ itself none.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    action TEXT NOT NULL CONSTRAINT audit_events_action_check
+        CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge')),
+    actor TEXT NOT NULL DEFAULT '',
+    faq_id UUID NOT NULL,
+    locale TEXT NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    request_id TEXT NOT NULL DEFAULT '',
+    client_ip TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_created_at_id_idx ON audit_events (created_at, id);
+CREATE INDEX IF NOT EXISTS audit_events_faq_id_idx ON audit_events (faq_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_events_actor_idx ON audit_events (actor, created_at);
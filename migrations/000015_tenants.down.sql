@@ -0,0 +1,31 @@
+-- Fails while several tenants share a slug or a position; remove all
+-- tenants but the default one first.
+DROP INDEX IF EXISTS audit_events_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS audit_events_created_at_id_idx ON audit_events (created_at, id);
+
+DROP INDEX IF EXISTS faqs_created_at_id_idx;
+DROP INDEX IF EXISTS faqs_updated_at_id_idx;
+CREATE INDEX IF NOT EXISTS faqs_created_at_id_idx ON faqs (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS faqs_updated_at_id_idx ON faqs (updated_at, id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS faqs_category_position_key;
+CREATE UNIQUE INDEX IF NOT EXISTS faqs_category_position_key
+    ON faqs (category_id, position) NULLS NOT DISTINCT
+    WHERE deleted_at IS NULL;
+
+ALTER TABLE faqs DROP CONSTRAINT IF EXISTS faqs_category_id_fkey;
+ALTER TABLE faqs ADD CONSTRAINT faqs_category_id_fkey
+    FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE SET NULL;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_parent_id_fkey;
+ALTER TABLE categories ADD CONSTRAINT categories_parent_id_fkey
+    FOREIGN KEY (parent_id) REFERENCES categories (id) ON DELETE SET NULL;
+
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_tenant_id_key;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_tenant_slug_key;
+ALTER TABLE categories ADD CONSTRAINT categories_slug_key UNIQUE (slug);
+
+ALTER TABLE api_keys DROP COLUMN IF EXISTS tenant;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS tenant;
+ALTER TABLE faq_revisions DROP COLUMN IF EXISTS tenant;
+ALTER TABLE faqs DROP COLUMN IF EXISTS tenant;
+ALTER TABLE categories DROP COLUMN IF EXISTS tenant;
@@ -0,0 +1,37 @@
+-- Everything stored before tenants existed belongs to the default tenant.
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS tenant TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS tenant TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE faq_revisions ADD COLUMN IF NOT EXISTS tenant TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS tenant TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS tenant TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE categories ALTER COLUMN tenant DROP DEFAULT;
+ALTER TABLE faqs ALTER COLUMN tenant DROP DEFAULT;
+ALTER TABLE faq_revisions ALTER COLUMN tenant DROP DEFAULT;
+ALTER TABLE audit_events ALTER COLUMN tenant DROP DEFAULT;
+ALTER TABLE api_keys ALTER COLUMN tenant DROP DEFAULT;
+
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_slug_key;
+ALTER TABLE categories ADD CONSTRAINT categories_tenant_slug_key UNIQUE (tenant, slug);
+ALTER TABLE categories ADD CONSTRAINT categories_tenant_id_key UNIQUE (tenant, id);
+
+-- references never cross tenants
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_parent_id_fkey;
+ALTER TABLE categories ADD CONSTRAINT categories_parent_id_fkey
+    FOREIGN KEY (tenant, parent_id) REFERENCES categories (tenant, id) ON DELETE SET NULL (parent_id);
+ALTER TABLE faqs DROP CONSTRAINT IF EXISTS faqs_category_id_fkey;
+ALTER TABLE faqs ADD CONSTRAINT faqs_category_id_fkey
+    FOREIGN KEY (tenant, category_id) REFERENCES categories (tenant, id) ON DELETE SET NULL (category_id);
+
+DROP INDEX IF EXISTS faqs_category_position_key;
+CREATE UNIQUE INDEX IF NOT EXISTS faqs_category_position_key
+    ON faqs (tenant, category_id, position) NULLS NOT DISTINCT
+    WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS faqs_created_at_id_idx;
+DROP INDEX IF EXISTS faqs_updated_at_id_idx;
+CREATE INDEX IF NOT EXISTS faqs_created_at_id_idx ON faqs (tenant, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS faqs_updated_at_id_idx ON faqs (tenant, updated_at, id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS audit_events_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS audit_events_created_at_id_idx ON audit_events (tenant, created_at, id);
@@ -0,0 +1,2 @@
+ALTER TABLE faqs DROP CONSTRAINT IF EXISTS faqs_content_format_check;
+ALTER TABLE faqs DROP COLUMN IF EXISTS content_format;
@@ -0,0 +1,4 @@
+-- Content stored before formats existed is plain text.
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS content_format TEXT NOT NULL DEFAULT 'plain';
+ALTER TABLE faqs ADD CONSTRAINT faqs_content_format_check
+    CHECK (content_format IN ('plain', 'markdown', 'html'));
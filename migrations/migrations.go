@@ -0,0 +1,11 @@
+// Package migrations embeds the SQL migrations of the storage drivers.
+// Postgres migrations live in this directory, SQLite ones in sqlite/.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var Postgres embed.FS
+
+//go:embed sqlite/*.sql
+var SQLite embed.FS
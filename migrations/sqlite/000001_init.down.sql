@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS faq_revisions;
+DROP TABLE IF EXISTS faq_translations;
+DROP TABLE IF EXISTS faqs;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,70 @@
+-- SQLite has no uuid, boolean or timestamptz types: IDs are stored as
+-- lowercase text, flags as 0/1 and timestamps as fixed-width UTC text
+-- (2006-01-02T15:04:05.000000Z), so that text order is time order.
+
+CREATE TABLE IF NOT EXISTS categories (
+    id TEXT PRIMARY KEY,
+    parent_id TEXT REFERENCES categories (id) ON DELETE SET NULL,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT categories_slug_key UNIQUE (slug)
+);
+
+CREATE INDEX IF NOT EXISTS categories_parent_position_idx ON categories (parent_id, position);
+
+CREATE TABLE IF NOT EXISTS faqs (
+    id TEXT PRIMARY KEY,
+    category_id TEXT REFERENCES categories (id) ON DELETE SET NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    is_active INTEGER NOT NULL DEFAULT 1,
+    status TEXT NOT NULL DEFAULT 'published',
+    draft_title TEXT,
+    draft_content TEXT,
+    published_at TEXT,
+    publish_at TEXT,
+    expire_at TEXT,
+    deleted_at TEXT,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT faqs_status_check CHECK (status IN ('draft', 'in_review', 'published', 'archived')),
+    CONSTRAINT faqs_schedule_check CHECK (publish_at IS NULL OR expire_at IS NULL OR expire_at > publish_at)
+);
+
+-- unique indexes treat NULLs as distinct, FAQs without a category are
+-- grouped under ''
+CREATE UNIQUE INDEX IF NOT EXISTS faqs_category_position_key
+    ON faqs (IFNULL(category_id, ''), position)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS faqs_deleted_at_idx ON faqs (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS faqs_created_at_id_idx ON faqs (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS faqs_updated_at_id_idx ON faqs (updated_at, id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS faq_translations (
+    faq_id TEXT NOT NULL REFERENCES faqs (id) ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    PRIMARY KEY (faq_id, locale)
+);
+
+CREATE INDEX IF NOT EXISTS faq_translations_locale_idx ON faq_translations (locale);
+
+-- revisions outlive their FAQ, so there is no foreign key
+CREATE TABLE IF NOT EXISTS faq_revisions (
+    faq_id TEXT NOT NULL,
+    revision INTEGER NOT NULL,
+    action TEXT NOT NULL,
+    actor TEXT NOT NULL DEFAULT '',
+    snapshot TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    PRIMARY KEY (faq_id, revision)
+);
@@ -0,0 +1,4 @@
+-- Keys created before roles existed could do everything. SQLite cannot
+-- drop a column default, so the repository always sets the role.
+ALTER TABLE api_keys ADD COLUMN role TEXT NOT NULL DEFAULT 'admin'
+    CONSTRAINT api_keys_role_check CHECK (role IN ('viewer', 'editor', 'publisher', 'admin'));
@@ -0,0 +1,19 @@
+-- No foreign key to faqs: the log must outlive the FAQ it describes.
+-- Events of a translation name its locale, events of the FAQ itself none.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id TEXT PRIMARY KEY,
+    action TEXT NOT NULL CONSTRAINT audit_events_action_check
+        CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge')),
+    actor TEXT NOT NULL DEFAULT '',
+    faq_id TEXT NOT NULL,
+    locale TEXT NOT NULL DEFAULT '',
+    before TEXT,
+    after TEXT,
+    request_id TEXT NOT NULL DEFAULT '',
+    client_ip TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_created_at_id_idx ON audit_events (created_at, id);
+CREATE INDEX IF NOT EXISTS audit_events_faq_id_idx ON audit_events (faq_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_events_actor_idx ON audit_events (actor, created_at);
@@ -0,0 +1,67 @@
+-- Fails while several tenants share a slug or a position; remove all
+-- tenants but the default one first.
+
+DROP INDEX IF EXISTS audit_events_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS audit_events_created_at_id_idx ON audit_events (created_at, id);
+
+ALTER TABLE api_keys DROP COLUMN tenant;
+ALTER TABLE audit_events DROP COLUMN tenant;
+ALTER TABLE faq_revisions DROP COLUMN tenant;
+
+CREATE TABLE categories_old (
+    id TEXT PRIMARY KEY,
+    parent_id TEXT REFERENCES categories (id) ON DELETE SET NULL,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT categories_slug_key UNIQUE (slug)
+);
+
+INSERT INTO categories_old (id, parent_id, name, slug, position, created_at, updated_at)
+SELECT id, parent_id, name, slug, position, created_at, updated_at
+FROM categories;
+
+DROP TABLE categories;
+ALTER TABLE categories_old RENAME TO categories;
+
+CREATE INDEX IF NOT EXISTS categories_parent_position_idx ON categories (parent_id, position);
+
+CREATE TABLE faqs_old (
+    id TEXT PRIMARY KEY,
+    category_id TEXT REFERENCES categories (id) ON DELETE SET NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    is_active INTEGER NOT NULL DEFAULT 1,
+    status TEXT NOT NULL DEFAULT 'published',
+    draft_title TEXT,
+    draft_content TEXT,
+    published_at TEXT,
+    publish_at TEXT,
+    expire_at TEXT,
+    deleted_at TEXT,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT faqs_status_check CHECK (status IN ('draft', 'in_review', 'published', 'archived')),
+    CONSTRAINT faqs_schedule_check CHECK (publish_at IS NULL OR expire_at IS NULL OR expire_at > publish_at)
+);
+
+INSERT INTO faqs_old (id, category_id, title, content, position, is_active, status, draft_title,
+    draft_content, published_at, publish_at, expire_at, deleted_at, version, created_at, updated_at)
+SELECT id, category_id, title, content, position, is_active, status, draft_title,
+    draft_content, published_at, publish_at, expire_at, deleted_at, version, created_at, updated_at
+FROM faqs;
+
+DROP TABLE faqs;
+ALTER TABLE faqs_old RENAME TO faqs;
+
+CREATE UNIQUE INDEX IF NOT EXISTS faqs_category_position_key
+    ON faqs (IFNULL(category_id, ''), position)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS faqs_deleted_at_idx ON faqs (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS faqs_created_at_id_idx ON faqs (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS faqs_updated_at_id_idx ON faqs (updated_at, id) WHERE deleted_at IS NULL;
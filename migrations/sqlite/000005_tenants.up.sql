@@ -0,0 +1,75 @@
+-- Everything stored before tenants existed belongs to the default tenant.
+-- SQLite cannot change constraints in place, so categories and faqs are
+-- rebuilt; the migrator turns foreign keys off while it runs. References
+-- have no ON DELETE action: SET NULL would clear the tenant as well, so
+-- the repository detaches FAQs and subcategories itself.
+
+CREATE TABLE categories_new (
+    id TEXT PRIMARY KEY,
+    tenant TEXT NOT NULL,
+    parent_id TEXT,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT categories_tenant_slug_key UNIQUE (tenant, slug),
+    CONSTRAINT categories_tenant_id_key UNIQUE (tenant, id),
+    CONSTRAINT categories_parent_id_fkey FOREIGN KEY (tenant, parent_id) REFERENCES categories (tenant, id)
+);
+
+INSERT INTO categories_new (id, tenant, parent_id, name, slug, position, created_at, updated_at)
+SELECT id, 'default', parent_id, name, slug, position, created_at, updated_at
+FROM categories;
+
+DROP TABLE categories;
+ALTER TABLE categories_new RENAME TO categories;
+
+CREATE INDEX IF NOT EXISTS categories_parent_position_idx ON categories (parent_id, position);
+
+CREATE TABLE faqs_new (
+    id TEXT PRIMARY KEY,
+    tenant TEXT NOT NULL,
+    category_id TEXT,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    is_active INTEGER NOT NULL DEFAULT 1,
+    status TEXT NOT NULL DEFAULT 'published',
+    draft_title TEXT,
+    draft_content TEXT,
+    published_at TEXT,
+    publish_at TEXT,
+    expire_at TEXT,
+    deleted_at TEXT,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT faqs_category_id_fkey FOREIGN KEY (tenant, category_id) REFERENCES categories (tenant, id),
+    CONSTRAINT faqs_status_check CHECK (status IN ('draft', 'in_review', 'published', 'archived')),
+    CONSTRAINT faqs_schedule_check CHECK (publish_at IS NULL OR expire_at IS NULL OR expire_at > publish_at)
+);
+
+INSERT INTO faqs_new (id, tenant, category_id, title, content, position, is_active, status, draft_title,
+    draft_content, published_at, publish_at, expire_at, deleted_at, version, created_at, updated_at)
+SELECT id, 'default', category_id, title, content, position, is_active, status, draft_title,
+    draft_content, published_at, publish_at, expire_at, deleted_at, version, created_at, updated_at
+FROM faqs;
+
+DROP TABLE faqs;
+ALTER TABLE faqs_new RENAME TO faqs;
+
+CREATE UNIQUE INDEX IF NOT EXISTS faqs_category_position_key
+    ON faqs (tenant, IFNULL(category_id, ''), position)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS faqs_deleted_at_idx ON faqs (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS faqs_created_at_id_idx ON faqs (tenant, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS faqs_updated_at_id_idx ON faqs (tenant, updated_at, id) WHERE deleted_at IS NULL;
+
+ALTER TABLE faq_revisions ADD COLUMN tenant TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE audit_events ADD COLUMN tenant TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE api_keys ADD COLUMN tenant TEXT NOT NULL DEFAULT 'default';
+
+DROP INDEX IF EXISTS audit_events_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS audit_events_created_at_id_idx ON audit_events (tenant, created_at, id);
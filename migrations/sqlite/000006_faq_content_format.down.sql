@@ -0,0 +1 @@
+ALTER TABLE faqs DROP COLUMN content_format;
@@ -0,0 +1,3 @@
+-- Content stored before formats existed is plain text.
+ALTER TABLE faqs ADD COLUMN content_format TEXT NOT NULL DEFAULT 'plain'
+    CONSTRAINT faqs_content_format_check CHECK (content_format IN ('plain', 'markdown', 'html'));
@@ -0,0 +1,444 @@
+// Package migrate applies versioned SQL migrations and records them in the
+// schema_migrations table.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect holds the statements that differ between databases.
+type Dialect struct {
+	// CreateTable creates schema_migrations if it does not exist.
+	CreateTable string
+	// Lock and Unlock keep concurrent runners apart. They are empty when
+	// the database serializes the migration transactions by itself.
+	Lock   string
+	Unlock string
+	// DisableForeignKeys and EnableForeignKeys run on the migration
+	// connection around all steps, for databases that can only rebuild a
+	// referenced table with foreign keys off. CheckForeignKeys then runs at
+	// the end of every step and must return no rows.
+	DisableForeignKeys string
+	EnableForeignKeys  string
+	CheckForeignKeys   string
+}
+
+// lockKey identifies the migration runner among the advisory locks of the
+// database.
+const lockKey = 7_263_541_830
+
+var Postgres = Dialect{
+	CreateTable: `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)
+	`,
+	Lock:   fmt.Sprintf(`SELECT pg_advisory_lock(%d)`, lockKey),
+	Unlock: fmt.Sprintf(`SELECT pg_advisory_unlock(%d)`, lockKey),
+}
+
+// SQLite takes the write lock when a transaction begins, so a second
+// runner waits for the current migration and then sees it recorded.
+// The pkg/db/sqlite driver opens transactions that way. The foreign_keys
+// pragma is ignored inside a transaction, hence the connection-wide
+// switch.
+var SQLite = Dialect{
+	CreateTable: `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`,
+	DisableForeignKeys: `PRAGMA foreign_keys = OFF`,
+	EnableForeignKeys:  `PRAGMA foreign_keys = ON`,
+	CheckForeignKeys:   `PRAGMA foreign_key_check`,
+}
+
+// Migration is a pair of scripts named NNNNNN_name.up.sql and
+// NNNNNN_name.down.sql. Down is empty when there is no down script.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status describes a known or applied migration. AppliedAt is nil for
+// pending migrations; Missing is set for applied migrations whose scripts
+// are not embedded in this binary.
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	Missing   bool
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []Migration
+}
+
+// New reads the migrations of fsys. Files that are not named like
+// migrations are ignored.
+func New(db *sql.DB, dialect Dialect, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up(ctx context.Context) error {
+	if len(m.migrations) == 0 {
+		return nil
+	}
+	return m.Goto(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down rolls back the last steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be greater than 0")
+	}
+	return m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		versions := sortedVersions(applied)
+		if steps > len(versions) {
+			steps = len(versions)
+		}
+		for i := len(versions) - 1; i >= len(versions)-steps; i-- {
+			if err := m.down(ctx, conn, versions[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Goto migrates up or down so that exactly the migrations up to version
+// are applied. Version 0 rolls back everything.
+func (m *Migrator) Goto(ctx context.Context, version int64) error {
+	if version < 0 {
+		return fmt.Errorf("version must not be negative")
+	}
+	if version > 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	return m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		versions := sortedVersions(applied)
+		for i := len(versions) - 1; i >= 0 && versions[i] > version; i-- {
+			if err := m.down(ctx, conn, versions[i]); err != nil {
+				return err
+			}
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := m.up(ctx, conn, mig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Status lists the known migrations together with the applied ones that
+// are unknown to this binary, ordered by version.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var out []Status
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		out = make([]Status, 0, len(m.migrations))
+		for _, mig := range m.migrations {
+			s := Status{Version: mig.Version, Name: mig.Name}
+			if a, ok := applied[mig.Version]; ok {
+				s.AppliedAt = a.AppliedAt
+				delete(applied, mig.Version)
+			}
+			out = append(out, s)
+		}
+		for _, a := range applied {
+			out = append(out, a)
+		}
+		sort.Slice(out, func(i, j int) bool {
+			return out[i].Version < out[j].Version
+		})
+		return nil
+	})
+	return out, err
+}
+
+// locked runs fn on a single connection holding the migration lock, after
+// making sure schema_migrations exists.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if m.dialect.Lock != "" {
+		if _, err := conn.ExecContext(ctx, m.dialect.Lock); err != nil {
+			return fmt.Errorf("acquire migration lock: %w", err)
+		}
+		defer func() {
+			// the lock is released with the session anyway, so a failed
+			// unlock only matters if nothing else went wrong
+			if _, unlockErr := conn.ExecContext(context.Background(), m.dialect.Unlock); unlockErr != nil && err == nil {
+				err = fmt.Errorf("release migration lock: %w", unlockErr)
+			}
+		}()
+	}
+
+	if _, err := conn.ExecContext(ctx, m.dialect.CreateTable); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	if m.dialect.DisableForeignKeys != "" {
+		if _, err := conn.ExecContext(ctx, m.dialect.DisableForeignKeys); err != nil {
+			return fmt.Errorf("disable foreign keys: %w", err)
+		}
+		defer func() {
+			// the connection goes back to the pool, so it must not keep
+			// foreign keys off
+			if _, enableErr := conn.ExecContext(context.Background(), m.dialect.EnableForeignKeys); enableErr != nil {
+				_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+				if err == nil {
+					err = fmt.Errorf("enable foreign keys: %w", enableErr)
+				}
+			}
+		}()
+	}
+	return fn(conn)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]Status, error) {
+	const q = `SELECT version, name, applied_at FROM schema_migrations`
+
+	rows, err := conn.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("list applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[int64]Status)
+	for rows.Next() {
+		var (
+			s         Status
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&s.Version, &s.Name, &appliedAt); err != nil {
+			return nil, fmt.Errorf("scan applied migration: %w", err)
+		}
+		s.AppliedAt = &appliedAt
+		s.Missing = m.find(s.Version) == nil
+		out[s.Version] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate applied migrations: %w", err)
+	}
+	return out, nil
+}
+
+func (m *Migrator) up(ctx context.Context, conn *sql.Conn, mig Migration) error {
+	const q = `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`
+
+	err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if done, err := recorded(ctx, tx, mig.Version); err != nil || done {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+			return err
+		}
+		if err := m.checkForeignKeys(ctx, tx); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, q, mig.Version, mig.Name)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("apply migration %d_%s: %w", mig.Version, mig.Name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) down(ctx context.Context, conn *sql.Conn, version int64) error {
+	const q = `DELETE FROM schema_migrations WHERE version = $1`
+
+	mig := m.find(version)
+	if mig == nil {
+		return fmt.Errorf("roll back migration %d: scripts not found", version)
+	}
+	if mig.Down == "" {
+		return fmt.Errorf("roll back migration %d_%s: no down script", mig.Version, mig.Name)
+	}
+	err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if done, err := recorded(ctx, tx, mig.Version); err != nil || !done {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+			return err
+		}
+		if err := m.checkForeignKeys(ctx, tx); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, q, mig.Version)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("roll back migration %d_%s: %w", mig.Version, mig.Name, err)
+	}
+	return nil
+}
+
+// checkForeignKeys fails if a step left rows that break a foreign key
+// while the database was not enforcing them.
+func (m *Migrator) checkForeignKeys(ctx context.Context, tx *sql.Tx) error {
+	if m.dialect.CheckForeignKeys == "" {
+		return nil
+	}
+	rows, err := tx.QueryContext(ctx, m.dialect.CheckForeignKeys)
+	if err != nil {
+		return fmt.Errorf("check foreign keys: %w", err)
+	}
+	defer rows.Close()
+	if rows.Next() {
+		return fmt.Errorf("foreign key violations left behind")
+	}
+	return rows.Err()
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	i := sort.Search(len(m.migrations), func(i int) bool {
+		return m.migrations[i].Version >= version
+	})
+	if i < len(m.migrations) && m.migrations[i].Version == version {
+		return &m.migrations[i]
+	}
+	return nil
+}
+
+// recorded reports whether a migration is applied. It is checked again
+// inside the migration transaction for databases without a Lock statement.
+func recorded(ctx context.Context, tx *sql.Tx, version int64) (bool, error) {
+	const q = `SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)`
+
+	var out bool
+	if err := tx.QueryRowContext(ctx, q, version).Scan(&out); err != nil {
+		return false, fmt.Errorf("check migration %d: %w", version, err)
+	}
+	return out, nil
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("list migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		base := path.Base(file)
+		var direction string
+		switch {
+		case strings.HasSuffix(base, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(base, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+		prefix, name, ok := strings.Cut(strings.TrimSuffix(base, "."+direction+".sql"), "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s: name must look like NNNNNN_name.%s.sql", base, direction)
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: version must be a positive number", base)
+		}
+		script, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", base, err)
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: name}
+			byVersion[version] = mig
+		}
+		if mig.Name != name {
+			return nil, fmt.Errorf("migration %d: names %q and %q do not match", version, mig.Name, name)
+		}
+		if direction == "up" {
+			mig.Up = string(script)
+		} else {
+			mig.Down = string(script)
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s: no up script", mig.Version, mig.Name)
+		}
+		out = append(out, *mig)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Version < out[j].Version
+	})
+	return out, nil
+}
+
+func sortedVersions(applied map[int64]Status) []int64 {
+	out := make([]int64, 0, len(applied))
+	for v := range applied {
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i] < out[j]
+	})
+	return out
+}
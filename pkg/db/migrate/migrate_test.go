@@ -0,0 +1,145 @@
+package migrate_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/nightmaker00/accordion-go/pkg/db/migrate"
+	"github.com/nightmaker00/accordion-go/pkg/db/sqlite"
+)
+
+var scripts = fstest.MapFS{
+	"000001_a.up.sql":   {Data: []byte(`CREATE TABLE a (id INTEGER);`)},
+	"000001_a.down.sql": {Data: []byte(`DROP TABLE a;`)},
+	"000002_b.up.sql":   {Data: []byte(`CREATE TABLE b (id INTEGER); CREATE TABLE c (id INTEGER);`)},
+	"000002_b.down.sql": {Data: []byte(`DROP TABLE c; DROP TABLE b;`)},
+	"000003_d.up.sql":   {Data: []byte(`CREATE TABLE d (id INTEGER);`)},
+	"README.md":         {Data: []byte(`not a migration`)},
+}
+
+func newMigrator(t *testing.T, path string) *migrate.Migrator {
+	t.Helper()
+	db, err := sqlite.Open(sqlite.Config{Path: path})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	m, err := migrate.New(db, migrate.SQLite, scripts)
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	return m
+}
+
+func applied(t *testing.T, m *migrate.Migrator) []int64 {
+	t.Helper()
+	statuses, err := m.Status(context.Background())
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	out := make([]int64, 0)
+	for _, s := range statuses {
+		if s.AppliedAt != nil {
+			out = append(out, s.Version)
+		}
+	}
+	return out
+}
+
+func assertApplied(t *testing.T, m *migrate.Migrator, want ...int64) {
+	t.Helper()
+	got := applied(t, m)
+	if len(got) != len(want) {
+		t.Fatalf("applied = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("applied = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	m := newMigrator(t, filepath.Join(t.TempDir(), "test.db"))
+
+	assertApplied(t, m)
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	assertApplied(t, m, 1, 2, 3)
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("up again: %v", err)
+	}
+
+	// migration 3 has no down script
+	if err := m.Down(ctx, 1); err == nil {
+		t.Fatal("down without a down script succeeded")
+	}
+	assertApplied(t, m, 1, 2, 3)
+
+	if err := m.Goto(ctx, 1); err == nil {
+		t.Fatal("goto across a missing down script succeeded")
+	}
+	if err := m.Goto(ctx, 7); err == nil {
+		t.Fatal("goto to an unknown version succeeded")
+	}
+}
+
+func TestMigratorGoto(t *testing.T) {
+	ctx := context.Background()
+	m := newMigrator(t, filepath.Join(t.TempDir(), "test.db"))
+
+	if err := m.Goto(ctx, 2); err != nil {
+		t.Fatalf("goto 2: %v", err)
+	}
+	assertApplied(t, m, 1, 2)
+	if err := m.Down(ctx, 1); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	assertApplied(t, m, 1)
+	if err := m.Goto(ctx, 0); err != nil {
+		t.Fatalf("goto 0: %v", err)
+	}
+	assertApplied(t, m)
+	if err := m.Goto(ctx, 2); err != nil {
+		t.Fatalf("goto 2 after rolling back: %v", err)
+	}
+	assertApplied(t, m, 1, 2)
+}
+
+func TestMigratorConcurrentRunners(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	runners := make([]*migrate.Migrator, 4)
+	for i := range runners {
+		runners[i] = newMigrator(t, path)
+	}
+	// a new file is switched to WAL on first connect, which SQLite does
+	// not let concurrent connections wait for
+	if _, err := runners[0].Status(context.Background()); err != nil {
+		t.Fatalf("status: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(runners))
+	for i, m := range runners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = m.Up(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("runner %d: %v", i, err)
+		}
+	}
+	assertApplied(t, runners[0], 1, 2, 3)
+}
@@ -0,0 +1,27 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open opens the database file, creating it if needed. Foreign keys are
+// enforced, writers wait for each other instead of failing, and
+// transactions take the write lock up front so that they never have to
+// upgrade a read lock midway.
+func Open(cfg Config) (*sql.DB, error) {
+	params := url.Values{}
+	params.Set("_foreign_keys", "on")
+	params.Set("_busy_timeout", "5000")
+	params.Set("_journal_mode", "WAL")
+	params.Set("_txlock", "immediate")
+
+	db, err := sql.Open("sqlite3", "file:"+cfg.Path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite: %w", err)
+	}
+	return db, nil
+}
@@ -0,0 +1,122 @@
+// Package jwks reads JSON Web Key Sets (RFC 7517) holding the keys that
+// verify tokens: "oct" secrets for HMAC and "RSA" public keys.
+package jwks
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// Key is a verification key. Key is a []byte for HMAC and a
+// *rsa.PublicKey for RSA.
+type Key struct {
+	ID        string
+	Algorithm string
+	Key       any
+}
+
+type Set struct {
+	keys []Key
+}
+
+// Load reads a key set from a JSON file.
+func Load(path string) (*Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key set: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a key set. Keys used only for encryption are skipped.
+func Parse(data []byte) (*Set, error) {
+	var raw struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Alg string `json:"alg"`
+			Use string `json:"use"`
+			K   string `json:"k"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("decode key set: %w", err)
+	}
+
+	set := &Set{}
+	for i, k := range raw.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key := Key{ID: k.Kid, Algorithm: k.Alg}
+		switch k.Kty {
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(k.K)
+			if err != nil || len(secret) == 0 {
+				return nil, fmt.Errorf("key %d: invalid k", i)
+			}
+			key.Key = secret
+		case "RSA":
+			n, err := decodeInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("key %d: invalid n", i)
+			}
+			e, err := decodeInt(k.E)
+			if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+				return nil, fmt.Errorf("key %d: invalid e", i)
+			}
+			key.Key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		default:
+			return nil, fmt.Errorf("key %d: unsupported key type %q", i, k.Kty)
+		}
+		set.keys = append(set.keys, key)
+	}
+	if len(set.keys) == 0 {
+		return nil, fmt.Errorf("key set has no signing keys")
+	}
+	return set, nil
+}
+
+// Find returns the keys that can verify a token signed with alg. A token
+// with a key ID only matches the key with that ID.
+func (s *Set) Find(kid, alg string) []any {
+	var out []any
+	for _, k := range s.keys {
+		if kid != "" && k.ID != kid {
+			continue
+		}
+		if k.Algorithm != "" && k.Algorithm != alg {
+			continue
+		}
+		switch k.Key.(type) {
+		case []byte:
+			if !strings.HasPrefix(alg, "HS") {
+				continue
+			}
+		case *rsa.PublicKey:
+			if !strings.HasPrefix(alg, "RS") {
+				continue
+			}
+		}
+		out = append(out, k.Key)
+	}
+	return out
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,111 @@
+package jwks
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestParse(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	n := encode(rsaKey.N.Bytes())
+	e := encode(big.NewInt(int64(rsaKey.E)).Bytes())
+
+	tests := []struct {
+		name    string
+		data    string
+		keys    int
+		wantErr string
+	}{
+		{"oct", `{"keys":[{"kty":"oct","kid":"a","alg":"HS256","k":"` + encode([]byte("secret")) + `"}]}`, 1, ""},
+		{"rsa", `{"keys":[{"kty":"RSA","kid":"b","use":"sig","n":"` + n + `","e":"` + e + `"}]}`, 1, ""},
+		{"encryption key skipped", `{"keys":[{"kty":"RSA","use":"enc","n":"` + n + `","e":"` + e + `"},{"kty":"oct","k":"c2VjcmV0"}]}`, 1, ""},
+		{"only encryption keys", `{"keys":[{"kty":"oct","use":"enc","k":"c2VjcmV0"}]}`, 0, "no signing keys"},
+		{"empty set", `{"keys":[]}`, 0, "no signing keys"},
+		{"not json", `keys`, 0, "decode key set"},
+		{"empty k", `{"keys":[{"kty":"oct","k":""}]}`, 0, "key 0: invalid k"},
+		{"padded k", `{"keys":[{"kty":"oct","k":"c2VjcmV0=="}]}`, 0, "key 0: invalid k"},
+		{"invalid n", `{"keys":[{"kty":"RSA","n":"!","e":"` + e + `"}]}`, 0, "key 0: invalid n"},
+		{"missing e", `{"keys":[{"kty":"RSA","n":"` + n + `"}]}`, 0, "key 0: invalid e"},
+		{"huge e", `{"keys":[{"kty":"RSA","n":"` + n + `","e":"` + encode(big.NewInt(1<<40).Bytes()) + `"}]}`, 0, "key 0: invalid e"},
+		{"ec", `{"keys":[{"kty":"oct","k":"c2VjcmV0"},{"kty":"EC","crv":"P-256"}]}`, 0, `key 1: unsupported key type "EC"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := Parse([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if len(set.keys) != tt.keys {
+				t.Errorf("got %d keys, want %d", len(set.keys), tt.keys)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	secret := []byte("secret")
+	set := &Set{keys: []Key{
+		{ID: "hs", Algorithm: "HS256", Key: secret},
+		// no alg, so only the key type limits the algorithms
+		{ID: "rs", Key: &rsaKey.PublicKey},
+		{Key: []byte("other")},
+	}}
+
+	tests := []struct {
+		name string
+		kid  string
+		alg  string
+		want []any
+	}{
+		{"kid and alg", "hs", "HS256", []any{secret}},
+		{"alg of another key", "hs", "HS512", nil},
+		{"unknown kid", "nope", "HS256", nil},
+		{"rsa", "rs", "RS256", []any{&rsaKey.PublicKey}},
+		{"rsa key for hmac", "rs", "HS256", nil},
+		{"hmac key for rsa", "hs", "RS256", nil},
+		{"no kid", "", "HS256", []any{secret, []byte("other")}},
+		{"no kid rsa", "", "RS512", []any{&rsaKey.PublicKey}},
+		{"none", "", "none", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := set.Find(tt.kid, tt.alg)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d keys, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				switch want := tt.want[i].(type) {
+				case []byte:
+					if g, ok := got[i].([]byte); !ok || string(g) != string(want) {
+						t.Errorf("key %d = %v, want %s", i, got[i], want)
+					}
+				case *rsa.PublicKey:
+					if got[i] != any(want) {
+						t.Errorf("key %d = %v, want the rsa key", i, got[i])
+					}
+				}
+			}
+		})
+	}
+}